@@ -9,9 +9,11 @@
 package easypost
 
 import (
-	"encoding/json"
-	"fmt"
+	"context"
+	"net/http"
 	"net/url"
+	"reflect"
+	"strings"
 )
 
 type Address struct {
@@ -86,40 +88,99 @@ type VerificationDetails struct {
 }
 
 func (c *Client) VerifyAndCreateAddress(address Address, verificationType VerificationType) (*Address, error) {
+	return c.VerifyAndCreateAddressContext(context.Background(), address, verificationType)
+}
+
+func (c *Client) VerifyAndCreateAddressContext(ctx context.Context, address Address, verificationType VerificationType) (*Address, error) {
 	parameters := url.Values{}
 	parameters.Set("verify_strict[]", string(verificationType))
-	parameters.Set("address[country]", address.Country)
-	parameters.Set("address[city]", address.City)
-	parameters.Set("address[street1]", address.Street1)
-	if address.State != "" {
-		parameters.Set("address[state]", address.State)
+	address.encode("address", parameters)
+
+	// Verification creates a new address object every time, but nothing
+	// refers to it, so the request is safe to retry.
+	responseBody, err := c.do(ctx, apiRequest{
+		method:     http.MethodPost,
+		path:       addressURL,
+		parameters: parameters,
+		idempotent: true,
+	})
+	if err != nil {
+		return nil, err
 	}
-	if address.Zip != "" {
-		parameters.Set("address[zip]", address.State)
+	return decodeResponse[Address](responseBody)
+}
+
+// encodeReference sets the address as parameters nested in another object
+// under prefix. Addresses already created in EasyPost are referred by their
+// ID only.
+func (a Address) encodeReference(prefix string, parameters url.Values) {
+	if a.ID != "" {
+		parameters.Set(prefix+"[id]", a.ID)
+		return
+	}
+	a.encode(prefix, parameters)
+}
+
+// encode sets address fields as parameters nested under prefix.
+func (a Address) encode(prefix string, parameters url.Values) {
+	parameters.Set(prefix+"[country]", a.Country)
+	parameters.Set(prefix+"[city]", a.City)
+	parameters.Set(prefix+"[street1]", a.Street1)
+	if a.State != "" {
+		parameters.Set(prefix+"[state]", a.State)
 	}
-	if address.Street2 != "" {
-		parameters.Set("address[street2]", address.Street2)
+	if a.Zip != "" {
+		parameters.Set(prefix+"[zip]", a.Zip)
 	}
-	if address.Company != nil {
-		parameters.Set("address[company]", *address.Company)
+	if a.Street2 != "" {
+		parameters.Set(prefix+"[street2]", a.Street2)
 	}
-	if address.Name != nil {
-		parameters.Set("address[name]", *address.Name)
+	if a.Company != nil {
+		parameters.Set(prefix+"[company]", *a.Company)
 	}
-	if address.Phone != nil {
-		parameters.Set("address[phone]", *address.Name)
+	if a.Name != nil {
+		parameters.Set(prefix+"[name]", *a.Name)
 	}
-	if address.Email != nil {
-		parameters.Set("address[email]", *address.Name)
+	if a.Phone != nil {
+		parameters.Set(prefix+"[phone]", *a.Phone)
 	}
+	if a.Email != nil {
+		parameters.Set(prefix+"[email]", *a.Email)
+	}
+}
 
-	responseBody, err := c.post(addressURL, parameters)
-	if err != nil {
-		return nil, err
+// addressFieldNames maps JSON names of Address fields to Go names.
+var addressFieldNames = func() map[string]string {
+	names := map[string]string{}
+	t := reflect.TypeOf(Address{})
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		names[name] = t.Field(i).Name
+	}
+	return names
+}()
+
+// AddressFieldName returns the name of the Address field, e.g. "Street1",
+// referred by field of EasyPost error, e.g. "street1" or
+// "to_address.street1". It returns false if field isn't an Address field.
+func AddressFieldName(field string) (string, bool) {
+	if i := strings.LastIndexAny(field, ".["); i >= 0 {
+		field = strings.TrimSuffix(field[i+1:], "]")
+	}
+	name, ok := addressFieldNames[field]
+	return name, ok
+}
+
+// ErrorsByField groups verification errors by the name of the Address
+// field they refer to, see AddressFieldName.
+func (v Verification) ErrorsByField() map[string][]AddressVerificationError {
+	if len(v.Errors) == 0 {
+		return nil
 	}
-	var verifiedAddress Address
-	if err := json.Unmarshal(responseBody, &verifiedAddress); err != nil {
-		return nil, fmt.Errorf("error decode response: %s", err)
+	grouped := map[string][]AddressVerificationError{}
+	for _, verificationError := range v.Errors {
+		name, _ := AddressFieldName(verificationError.Field)
+		grouped[name] = append(grouped[name], verificationError)
 	}
-	return &verifiedAddress, nil
+	return grouped
 }
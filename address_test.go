@@ -10,6 +10,10 @@ package easypost
 
 import (
 	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
 	"reflect"
 	"testing"
@@ -75,3 +79,72 @@ func TestInvalidAddress(t *testing.T) {
 		t.Fatalf("address error: \nexpected %+v\n     got %+v", expectedDetails, details)
 	}
 }
+
+func TestAddressFieldErrors(t *testing.T) {
+	setup()
+
+	_, err := testClient.VerifyAndCreateAddress(Address{
+		Street1: "address",
+	}, DeliveryVerification)
+	processingError, ok := err.(ProcessingError)
+	if !ok {
+		t.Fatalf("expected ProcessingError, got: %T(%s)", err, err)
+	}
+	if !errors.Is(err, AddressVerificationHouseNumberMissing) {
+		t.Fatalf("expected %s code", AddressVerificationHouseNumberMissing)
+	}
+
+	expectedFieldErrors := map[string][]FieldError{
+		"": {{
+			Code:    "E.ADDRESS.NOT_FOUND",
+			Field:   "address",
+			Message: "Address not found",
+		}},
+		"Street1": {{
+			Code:    "E.HOUSE_NUMBER.MISSING",
+			Field:   "street1",
+			Message: "House number is missing",
+		}},
+	}
+	if fieldErrors := processingError.AddressFieldErrors(); !reflect.DeepEqual(fieldErrors, expectedFieldErrors) {
+		t.Fatalf("field errors: \nexpected %+v\n     got %+v", expectedFieldErrors, fieldErrors)
+	}
+
+	for field, expectedName := range map[string]string{
+		"zip":                "Zip",
+		"to_address.street2": "Street2",
+		"address[country]":   "Country",
+		"address":            "",
+		"verify_strict[]":    "",
+	} {
+		if name, _ := AddressFieldName(field); name != expectedName {
+			t.Errorf("field %s: unexpected name, expected: %q, got: %q", field, expectedName, name)
+		}
+	}
+}
+
+func TestVerifyAddressWithID(t *testing.T) {
+	var query url.Values
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Write([]byte(`{"id": "adr_2", "object": "Address"}`))
+	}))
+	defer s.Close()
+	c := NewClient("", WithBaseURL(s.URL))
+
+	address := Address{ID: "adr_1", Street1: "417 Montgomery St", City: "San Francisco", Zip: "94104", Country: "US"}
+	if _, err := c.VerifyAndCreateAddress(address, DeliveryVerification); err != nil {
+		t.Fatal(err)
+	}
+	for key, expected := range map[string]string{
+		"address[street1]": "417 Montgomery St",
+		"address[city]":    "San Francisco",
+		"address[zip]":     "94104",
+		"address[country]": "US",
+		"address[id]":      "",
+	} {
+		if query.Get(key) != expected {
+			t.Fatalf("unexpected %s, expected: %q, got: %q", key, expected, query.Get(key))
+		}
+	}
+}
@@ -9,19 +9,31 @@
 package easypost
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strings"
+	"time"
 )
 
-var apiURL = "https://api.easypost.com/v2"
-
 const (
-	trackerURL = "trackers"
-	addressURL = "addresses"
+	defaultBaseURL   = "https://api.easypost.com/v2"
+	defaultUserAgent = "easypost-go"
+
+	trackerURL  = "trackers"
+	addressURL  = "addresses"
+	shipmentURL = "shipments"
+	parcelURL   = "parcels"
+	refundURL   = "refunds"
+	webhookURL  = "webhooks"
+	eventURL    = "events"
+
+	customsInfoURL = "customs_infos"
+	customsItemURL = "customs_items"
 )
 
 type Logger interface {
@@ -29,9 +41,73 @@ type Logger interface {
 }
 
 type Client struct {
-	c           http.Client
+	c           *http.Client
 	apiKey      string
+	baseURL     string
+	userAgent   string
+	retryPolicy *RetryPolicy
+	rateLimiter *RateLimiter
 	errorLogger Logger
+	handler     Handler
+
+	operationMiddlewares []OperationMiddleware
+
+	maxLabelSize int64
+}
+
+// Option configures a Client created by NewClient.
+type Option func(*clientOptions)
+
+type clientOptions struct {
+	httpClient      *http.Client
+	transport       http.RoundTripper
+	timeout         time.Duration
+	baseURL         string
+	userAgentSuffix string
+	retryPolicy     *RetryPolicy
+	rateLimiter     *RateLimiter
+	middlewares     []Middleware
+	maxLabelSize    int64
+
+	operationMiddlewares []OperationMiddleware
+}
+
+// WithHTTPClient makes the Client send requests through hc instead of a
+// default http.Client. hc itself is never modified.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(o *clientOptions) {
+		o.httpClient = hc
+	}
+}
+
+// WithRoundTripper sets the transport used to send requests.
+func WithRoundTripper(rt http.RoundTripper) Option {
+	return func(o *clientOptions) {
+		o.transport = rt
+	}
+}
+
+// WithTimeout limits the time of every request, including reading the
+// response body.
+func WithTimeout(d time.Duration) Option {
+	return func(o *clientOptions) {
+		o.timeout = d
+	}
+}
+
+// WithBaseURL points the Client to a different EasyPost API endpoint, e.g.
+// a test server.
+func WithBaseURL(baseURL string) Option {
+	return func(o *clientOptions) {
+		o.baseURL = strings.TrimSuffix(baseURL, "/")
+	}
+}
+
+// WithUserAgent appends suffix to the User-Agent header of every request.
+func WithUserAgent(suffix string) Option {
+	return func(o *clientOptions) {
+		o.userAgentSuffix = suffix
+	}
 }
 
 func (c *Client) SetErrorLog(l Logger) {
@@ -40,83 +116,269 @@ func (c *Client) SetErrorLog(l Logger) {
 
 func (c Client) errorf(f string, attr ...interface{}) {
 	if c.errorLogger != nil {
-		c.errorLogger.Printf(f, attr)
+		c.errorLogger.Printf(f, attr...)
+	}
+}
+
+func NewClient(apiKey string, options ...Option) *Client {
+	o := clientOptions{
+		baseURL:      defaultBaseURL,
+		maxLabelSize: defaultMaxLabelSize,
+	}
+	for _, option := range options {
+		option(&o)
+	}
+
+	hc := &http.Client{}
+	if o.httpClient != nil {
+		*hc = *o.httpClient
+	}
+	if o.transport != nil {
+		hc.Transport = o.transport
+	}
+	if o.timeout > 0 {
+		hc.Timeout = o.timeout
+	}
+
+	userAgent := defaultUserAgent
+	if o.userAgentSuffix != "" {
+		userAgent += " " + o.userAgentSuffix
+	}
+
+	c := &Client{
+		c:           hc,
+		apiKey:      apiKey,
+		baseURL:     o.baseURL,
+		userAgent:   userAgent,
+		retryPolicy: o.retryPolicy,
+		rateLimiter: o.rateLimiter,
+
+		maxLabelSize: o.maxLabelSize,
+
+		operationMiddlewares: o.operationMiddlewares,
+	}
+	c.handler = c.roundTrip
+	for i := len(o.middlewares) - 1; i >= 0; i-- {
+		c.handler = o.middlewares[i](c.handler)
 	}
+	return c
 }
 
-func NewClient(apiKey string) *Client {
-	return &Client{
-		c:      http.Client{},
-		apiKey: apiKey,
+// apiRequest describes a single call to the EasyPost API.
+type apiRequest struct {
+	method     string
+	path       string
+	parameters url.Values
+	// idempotent marks requests with unsafe methods which still can be
+	// repeated without side effects, so they can be retried.
+	idempotent bool
+}
+
+func (r apiRequest) retryable() bool {
+	switch r.method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
 	}
+	return r.idempotent
+}
+
+func (c *Client) post(ctx context.Context, objectURL string, parameters url.Values) ([]byte, error) {
+	return c.do(ctx, apiRequest{
+		method:     http.MethodPost,
+		path:       objectURL,
+		parameters: parameters,
+	})
+}
+
+func (c *Client) get(ctx context.Context, objectURL string, parameters url.Values) ([]byte, error) {
+	return c.do(ctx, apiRequest{
+		method:     http.MethodGet,
+		path:       objectURL,
+		parameters: parameters,
+	})
 }
 
-func (c *Client) post(objectURL string, parameters url.Values) ([]byte, error) {
+func (c *Client) do(ctx context.Context, req apiRequest) ([]byte, error) {
 	if c == nil {
 		panic("client is not initialized")
 	}
-	rawURL, err := url.ParseRequestURI(fmt.Sprintf("%s/%s?%s", apiURL, objectURL, parameters.Encode()))
+
+	handler := OperationHandler(func(ctx context.Context, _ Operation) (*Response, error) {
+		return c.attempt(ctx, req)
+	})
+	for i := len(c.operationMiddlewares) - 1; i >= 0; i-- {
+		handler = c.operationMiddlewares[i](handler)
+	}
+	response, err := handler(ctx, Operation{Method: req.method, Path: req.path})
+	if err != nil {
+		return nil, err
+	}
+	if response == nil {
+		return nil, ErrNoResponse
+	}
+	return response.Body, nil
+}
+
+// attempt makes attempts of req until one succeeds or the retry policy
+// gives up, it returns the response to the last attempt.
+func (c *Client) attempt(ctx context.Context, req apiRequest) (*Response, error) {
+	maxAttempts := 1
+	if c.retryPolicy != nil && req.retryable() {
+		maxAttempts = c.retryPolicy.MaxAttempts
+	}
+	for attempt := 1; ; attempt++ {
+		if c.rateLimiter != nil {
+			if err := c.rateLimiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+		response, err := c.send(ctx, req, attempt)
+		var header http.Header
+		if response != nil {
+			header = response.Header
+			storeResponseInfo(ctx, response.ResponseInfo)
+			if c.rateLimiter != nil {
+				c.rateLimiter.update(response.StatusCode, header)
+			}
+		}
+		if err == nil {
+			return response, nil
+		}
+		if attempt >= maxAttempts || ctx.Err() != nil {
+			return response, err
+		}
+		delay, ok := c.retryPolicy.delay(attempt, header, err)
+		if !ok {
+			return response, err
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			return response, err
+		}
+		c.errorf("retrying %s %s in %s: %s\n", req.method, req.path, delay, err)
+
+		t := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return response, ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// send makes a single attempt of req through the middlewares of the Client.
+func (c *Client) send(ctx context.Context, req apiRequest, attempt int) (*Response, error) {
+	rawURL, err := url.ParseRequestURI(fmt.Sprintf("%s/%s?%s", c.baseURL, req.path, req.parameters.Encode()))
 	if err != nil {
 		panic(err)
 	}
 
-	r, err := http.NewRequest("POST", rawURL.String(), nil)
+	ctx = context.WithValue(ctx, attemptKey{}, attemptInfo{path: req.path, attempt: attempt})
+	r, err := http.NewRequestWithContext(ctx, req.method, rawURL.String(), nil)
 	if err != nil {
 		panic(err)
 	}
 	r.SetBasicAuth(c.apiKey, "")
+	r.Header.Set("User-Agent", c.userAgent)
+	response, err := c.handler(r)
+	if response == nil && err == nil {
+		return nil, ErrNoResponse
+	}
+	return response, err
+}
 
+// roundTrip is the innermost Handler, it sends r to EasyPost.
+func (c *Client) roundTrip(r *http.Request) (*Response, error) {
+	start := time.Now()
 	response, err := c.c.Do(r)
 	if err != nil {
-		return nil, fmt.Errorf("error sending request: %s", err)
+		// The query of the URL in the error has the request parameters,
+		// which may contain personal data, e.g. addresses.
+		var urlError *url.Error
+		if errors.As(err, &urlError) {
+			u := *r.URL
+			u.RawQuery = ""
+			urlError.URL = u.Redacted()
+		}
+		return nil, TransportError{Err: err}
 	}
 	defer response.Body.Close()
 
-	if response.StatusCode >= 500 {
-		return nil, fmt.Errorf("request can't be processed by server")
+	body, err := ioutil.ReadAll(response.Body)
+	result := &Response{
+		ResponseInfo: newResponseInfo(response, time.Since(start)),
+		Header:       response.Header,
+	}
+	if err != nil {
+		err := TransportError{Err: fmt.Errorf("error read response: %w", err)}
+		c.errorf("%s\n", err)
+		return result, err
 	}
 
-	if response.StatusCode == http.StatusOK || response.StatusCode == http.StatusCreated {
-		body, err := ioutil.ReadAll(response.Body)
-		if err != nil {
-			err := fmt.Errorf("error read response: %s", err)
-			c.errorf("%s\n", err)
-			return nil, err
-		}
-		return body, nil
+	switch response.StatusCode {
+	case http.StatusOK, http.StatusCreated, http.StatusNoContent:
+		result.Body = body
+		return result, nil
 	}
 
-	err = c.processErrorResponse(response)
+	err = c.processErrorResponse(result.ResponseInfo, body)
 	if err != nil {
 		c.errorf("%s\n", err)
 	}
-	return nil, err
+	return result, err
 }
 
-func (c Client) processErrorResponse(response *http.Response) error {
-	switch response.StatusCode {
+// objectPath returns path of the object with id, optionally followed by
+// elem, e.g. shipments/shp_1/buy.
+func objectPath(objectURL, id string, elem ...string) string {
+	return strings.Join(append([]string{objectURL, url.PathEscape(id)}, elem...), "/")
+}
+
+func decodeResponse[T any](body []byte) (*T, error) {
+	v := new(T)
+	if err := json.Unmarshal(body, v); err != nil {
+		return nil, fmt.Errorf("error decode response: %s", err)
+	}
+	return v, nil
+}
+
+func (c Client) processErrorResponse(info ResponseInfo, b []byte) error {
+	switch info.StatusCode {
 	case http.StatusUnauthorized:
-		return unauthorizedError
+		return UnauthorizedError{ResponseInfo: info}
 	case http.StatusPaymentRequired:
-		return paymentError
+		return PaymentRequiredError{ResponseInfo: info}
 	case http.StatusNotFound:
-		return errors.New("resource is not reachable")
-	}
-
-	b, err := ioutil.ReadAll(response.Body)
-	if err != nil {
-		return fmt.Errorf("error reading error reponse: %s", err)
+		return NotFoundError{ResponseInfo: info}
 	}
 	c.errorf("error response body: %s\n", b)
 
 	errorResponse := ErrorResponse{}
-	if err := json.Unmarshal(b, &errorResponse); err != nil {
-		return fmt.Errorf("error parse not success response: %s", err)
-	}
+	parseErr := json.Unmarshal(b, &errorResponse)
 	errorMessage := errorResponse.Error
+
+	switch {
+	case info.StatusCode == http.StatusTooManyRequests:
+		return RateLimitError{
+			ResponseInfo: info,
+			Code:         ErrorCode(errorMessage.Code),
+			Message:      errorMessage.Message,
+		}
+	case info.StatusCode >= 500:
+		return ServerError{
+			ResponseInfo: info,
+			Code:         ErrorCode(errorMessage.Code),
+			Message:      errorMessage.Message,
+		}
+	}
+
+	if parseErr != nil {
+		return UnexpectedResponseError{ResponseInfo: info, Body: b, Err: parseErr}
+	}
 	return ProcessingError{
-		msg:     errorMessage.Message,
-		code:    errorMessage.Code,
-		details: errorMessage.FieldErrors,
+		ResponseInfo: info,
+		msg:          errorMessage.Message,
+		code:         errorMessage.Code,
+		details:      errorMessage.FieldErrors,
 	}
 }
@@ -16,20 +16,53 @@ import (
 	"net/http/httptest"
 	"os"
 	"path"
+	"reflect"
+	"slices"
+	"strconv"
 	"strings"
+	"testing"
+	"time"
 )
 
 var (
 	testServer *httptest.Server
-	testClient = NewClient("")
+	testClient *Client
+
+	testWebhooks []*Webhook
 )
 
 func setup() {
 	m := http.NewServeMux()
 	m.HandleFunc("/trackers", getTestTrackers)
+	m.HandleFunc("GET /trackers", listTestTrackers)
+	m.HandleFunc("GET /trackers/{id}", retrieveTestTracker)
+	m.HandleFunc("POST /trackers/create_list", createTestTrackerList)
 	m.HandleFunc("/addresses", validateTestAddress)
+	m.HandleFunc("POST /shipments", createTestShipment)
+	m.HandleFunc("GET /shipments/{id}", getTestShipment)
+	m.HandleFunc("POST /shipments/{id}/buy", buyTestShipment)
+	m.HandleFunc("GET /shipments/{id}/label", convertTestLabel)
+	m.HandleFunc("GET /files/{name}", getTestLabelFile)
+	m.HandleFunc("POST /shipments/{id}/refund", refundTestShipment)
+	m.HandleFunc("POST /refunds", createTestRefunds)
+	m.HandleFunc("POST /parcels", createTestParcel)
+	m.HandleFunc("GET /parcels/{id}", getTestParcel)
+	m.HandleFunc("POST /customs_infos", createTestCustomsInfo)
+	m.HandleFunc("GET /customs_infos/{id}", getTestCustoms)
+	m.HandleFunc("POST /customs_items", createTestCustomsItem)
+	m.HandleFunc("GET /customs_items/{id}", getTestCustoms)
+	m.HandleFunc("POST /webhooks", createTestWebhook)
+	m.HandleFunc("GET /webhooks", listTestWebhooks)
+	m.HandleFunc("GET /webhooks/{id}", getTestWebhook)
+	m.HandleFunc("PATCH /webhooks/{id}", updateTestWebhook)
+	m.HandleFunc("DELETE /webhooks/{id}", deleteTestWebhook)
+	m.HandleFunc("GET /events", listTestEvents)
+	m.HandleFunc("GET /events/{id}", getTestEvent)
+	m.HandleFunc("GET /events/{id}/payloads", listTestEventPayloads)
+	m.HandleFunc("GET /events/{id}/payloads/{payload_id}", getTestEventPayload)
+	testWebhooks = []*Webhook{}
 	testServer = httptest.NewServer(m)
-	apiURL = testServer.URL
+	testClient = NewClient("", WithBaseURL(testServer.URL))
 }
 
 func readTestTrackerFile(trackingCode string) ([]byte, error) {
@@ -41,6 +74,285 @@ func readTestTrackerFile(trackingCode string) ([]byte, error) {
 	return io.ReadAll(f)
 }
 
+func readTestShipmentFile(name string) ([]byte, error) {
+	return os.ReadFile(path.Join("./test/shipments", fmt.Sprintf("%s.json", name)))
+}
+
+func writeTestFile(w http.ResponseWriter, statusCode int, b []byte, err error) {
+	if err != nil {
+		if os.IsNotExist(err) {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+	w.WriteHeader(statusCode)
+	w.Write(b)
+}
+
+func writeTestProcessingError(w http.ResponseWriter, code ErrorCode, message string) {
+	w.WriteHeader(http.StatusUnprocessableEntity)
+	json.NewEncoder(w).Encode(ErrorResponse{
+		Error: errorMessage{
+			Code:    string(code),
+			Message: message,
+		},
+	})
+}
+
+func createTestShipment(w http.ResponseWriter, r *http.Request) {
+	if r.FormValue("shipment[to_address][street1]") == "" || r.FormValue("shipment[from_address][id]") == "" || r.FormValue("shipment[parcel][weight]") == "" {
+		writeTestProcessingError(w, "SHIPMENT.INVALID_PARAMS", "missing required parameters")
+		return
+	}
+	b, err := readTestShipmentFile("shp_1")
+	writeTestFile(w, http.StatusCreated, b, err)
+}
+
+func getTestShipment(w http.ResponseWriter, r *http.Request) {
+	b, err := readTestShipmentFile(r.PathValue("id"))
+	writeTestFile(w, http.StatusOK, b, err)
+}
+
+func buyTestShipment(w http.ResponseWriter, r *http.Request) {
+	if r.PathValue("id") != "shp_1" || r.FormValue("rate[id]") != "rate_1" {
+		writeTestProcessingError(w, "SHIPMENT.POSTAGE.FAILURE", "rate is not found")
+		return
+	}
+	b, err := readTestShipmentFile("shp_1_bought")
+	writeTestFile(w, http.StatusOK, b, err)
+}
+
+func convertTestLabel(w http.ResponseWriter, r *http.Request) {
+	b, err := readTestShipmentFile(r.PathValue("id") + "_bought")
+	if err != nil {
+		writeTestFile(w, http.StatusOK, b, err)
+		return
+	}
+	shipment := map[string]json.RawMessage{}
+	label := map[string]interface{}{}
+	if err := json.Unmarshal(b, &shipment); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if err := json.Unmarshal(shipment["postage_label"], &label); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	format := strings.ToLower(r.FormValue("file_format"))
+	label[fmt.Sprintf("label_%s_url", format)] = fmt.Sprintf("http://%s/files/pl_1.%s", r.Host, format)
+	shipment["postage_label"], _ = json.Marshal(label)
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(shipment)
+}
+
+func getTestLabelFile(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "" {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	switch path.Ext(r.PathValue("name")) {
+	case ".zpl":
+		w.Header().Set("Content-Type", "application/zpl")
+	case ".pdf":
+		w.Header().Set("Content-Type", "text/html")
+	}
+	w.Write([]byte("^XA^FO50,50^A0N,50,50^FDEasyPost^FS^XZ"))
+}
+
+func refundTestShipment(w http.ResponseWriter, r *http.Request) {
+	b, err := readTestShipmentFile(r.PathValue("id") + "_bought")
+	if err != nil {
+		writeTestFile(w, http.StatusOK, b, err)
+		return
+	}
+	shipment := map[string]interface{}{}
+	if err := json.Unmarshal(b, &shipment); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	shipment["refund_status"] = RefundStatusSubmitted
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(shipment)
+}
+
+func createTestRefunds(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	var refunds []Refund
+	for _, trackingCode := range r.Form["refund[tracking_codes][]"] {
+		refunds = append(refunds, Refund{
+			Object:       RecordTypeRefund,
+			TrackingCode: trackingCode,
+			Carrier:      Carrier(r.FormValue("refund[carrier]")),
+			Status:       RefundStatusSubmitted,
+		})
+	}
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(refunds)
+}
+
+func readTestParcelFile(name string) ([]byte, error) {
+	return os.ReadFile(path.Join("./test/parcels", fmt.Sprintf("%s.json", name)))
+}
+
+func createTestParcel(w http.ResponseWriter, r *http.Request) {
+	if r.FormValue("parcel[weight]") != "65.9" {
+		writeTestProcessingError(w, "PARCEL.INVALID_PARAMS", "unexpected weight")
+		return
+	}
+	b, err := readTestParcelFile("prcl_1")
+	writeTestFile(w, http.StatusCreated, b, err)
+}
+
+func getTestParcel(w http.ResponseWriter, r *http.Request) {
+	b, err := readTestParcelFile(r.PathValue("id"))
+	writeTestFile(w, http.StatusOK, b, err)
+}
+
+func readTestCustomsFile(name string) ([]byte, error) {
+	return os.ReadFile(path.Join("./test/customs", fmt.Sprintf("%s.json", name)))
+}
+
+func createTestCustomsInfo(w http.ResponseWriter, r *http.Request) {
+	if r.FormValue("customs_info[eel_pfc]") == "" || r.FormValue("customs_info[customs_items][0][hs_tariff_number]") != "610910" {
+		writeTestProcessingError(w, "CUSTOMS.INVALID_PARAMS", "missing required parameters")
+		return
+	}
+	b, err := readTestCustomsFile("cstinfo_1")
+	writeTestFile(w, http.StatusCreated, b, err)
+}
+
+func createTestCustomsItem(w http.ResponseWriter, r *http.Request) {
+	if r.FormValue("customs_item[hs_tariff_number]") != "610910" {
+		writeTestProcessingError(w, "CUSTOMS.INVALID_PARAMS", "missing required parameters")
+		return
+	}
+	b, err := readTestCustomsFile("cstitem_1")
+	writeTestFile(w, http.StatusCreated, b, err)
+}
+
+func getTestCustoms(w http.ResponseWriter, r *http.Request) {
+	b, err := readTestCustomsFile(r.PathValue("id"))
+	writeTestFile(w, http.StatusOK, b, err)
+}
+
+// testTrackers are existing trackers of the test server, from the newest to
+// the oldest one.
+func testTrackers() []Tracker {
+	var trackers []Tracker
+	for i := len(TestTrackerCodes); i > 0; i-- {
+		carrier := "USPS"
+		if i%2 == 0 {
+			carrier = "UPS"
+		}
+		trackers = append(trackers, Tracker{
+			ID:           fmt.Sprintf("trk_%d", i),
+			Object:       RecordTypeTracker,
+			TrackingCode: TestTrackerCodes[i-1],
+			Carrier:      carrier,
+			CreatedAt:    DateTime{time.Date(2026, 1, i, 0, 0, 0, 0, time.UTC)},
+			UpdatedAt:    DateTime{time.Date(2026, 1, i, 0, 0, 0, 0, time.UTC)},
+		})
+	}
+	return trackers
+}
+
+func retrieveTestTracker(w http.ResponseWriter, r *http.Request) {
+	for _, tracker := range testTrackers() {
+		if tracker.ID == r.PathValue("id") {
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(tracker)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusNotFound)
+}
+
+func listTestTrackers(w http.ResponseWriter, r *http.Request) {
+	page, hasMore, err := testPage(r, testTrackers(), func(tracker Tracker) (string, time.Time, bool) {
+		matches := (r.FormValue("tracking_code") == "" || tracker.TrackingCode == r.FormValue("tracking_code")) &&
+			(r.FormValue("carrier") == "" || tracker.Carrier == r.FormValue("carrier"))
+		return tracker.ID, tracker.CreatedAt.Time, matches
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(TrackerList{Trackers: page, HasMore: hasMore})
+}
+
+// testPage returns the page of records requested by list parameters of r.
+// Records must be sorted from the newest to the oldest one, key returns ID
+// and creation time of a record and whether it matches other filters.
+func testPage[T any](r *http.Request, records []T, key func(T) (string, time.Time, bool)) ([]T, bool, error) {
+	pageSize := 20
+	if r.FormValue("page_size") != "" {
+		var err error
+		if pageSize, err = strconv.Atoi(r.FormValue("page_size")); err != nil {
+			return nil, false, err
+		}
+	}
+	var start, end time.Time
+	if r.FormValue("start_datetime") != "" {
+		var err error
+		if start, err = time.Parse(time.RFC3339, r.FormValue("start_datetime")); err != nil {
+			return nil, false, err
+		}
+	}
+	if r.FormValue("end_datetime") != "" {
+		var err error
+		if end, err = time.Parse(time.RFC3339, r.FormValue("end_datetime")); err != nil {
+			return nil, false, err
+		}
+	}
+
+	indexOf := func(id string) int {
+		return slices.IndexFunc(records, func(record T) bool {
+			recordID, _, _ := key(record)
+			return recordID == id
+		})
+	}
+	if beforeID := r.FormValue("before_id"); beforeID != "" {
+		records = records[indexOf(beforeID)+1:]
+	}
+	if afterID := r.FormValue("after_id"); afterID != "" {
+		records = records[:max(indexOf(afterID), 0)]
+	}
+
+	var page []T
+	for _, record := range records {
+		_, createdAt, matches := key(record)
+		if !matches || (!start.IsZero() && createdAt.Before(start)) || (!end.IsZero() && !createdAt.Before(end)) {
+			continue
+		}
+		if len(page) == pageSize {
+			return page, true, nil
+		}
+		page = append(page, record)
+	}
+	return page, false, nil
+}
+
+func createTestTrackerList(w http.ResponseWriter, r *http.Request) {
+	for i := 0; ; i++ {
+		trackingCode := r.FormValue(fmt.Sprintf("trackers[%d][tracking_code]", i))
+		if trackingCode == "" {
+			break
+		}
+		if strings.HasPrefix(trackingCode, "INVALID") {
+			writeTestProcessingError(w, "TRACKER.INVALID_PARAMS", "invalid tracking code")
+			return
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("{}"))
+}
+
 func getTestTrackers(w http.ResponseWriter, r *http.Request) {
 	trackingCode := r.FormValue("tracker[tracking_code]")
 	switch trackingCode {
@@ -111,3 +423,192 @@ func validateTestAddress(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(responseCode)
 	w.Write(b)
 }
+
+func TestNewClientOptions(t *testing.T) {
+	var userAgents []string
+	newServer := func(name string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			userAgents = append(userAgents, r.UserAgent())
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"id": %q}`, name)
+		}))
+	}
+	first := newServer("first")
+	defer first.Close()
+	second := newServer("second")
+	defer second.Close()
+
+	hc := &http.Client{}
+	firstClient := NewClient("", WithBaseURL(first.URL), WithHTTPClient(hc), WithTimeout(time.Second))
+	secondClient := NewClient("", WithBaseURL(second.URL+"/"), WithUserAgent("test/1.0"))
+	if hc.Timeout != 0 {
+		t.Fatalf("http client is modified: %s", hc.Timeout)
+	}
+
+	for _, test := range []struct {
+		client     *Client
+		expectedID string
+	}{
+		{firstClient, "first"},
+		{secondClient, "second"},
+	} {
+		tracker, err := test.client.CreateTracker("EZ3000000003", "")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if tracker.ID != test.expectedID {
+			t.Errorf("unexpected tracker, expected: %s, got: %s", test.expectedID, tracker.ID)
+		}
+	}
+
+	expectedUserAgents := []string{"easypost-go", "easypost-go test/1.0"}
+	if !reflect.DeepEqual(userAgents, expectedUserAgents) {
+		t.Fatalf("user agents: \nexpected %v\n     got %v", expectedUserAgents, userAgents)
+	}
+}
+
+func writeTestJSON(w http.ResponseWriter, status int, v any) {
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func findTestWebhook(w http.ResponseWriter, r *http.Request) *Webhook {
+	for _, webhook := range testWebhooks {
+		if webhook.ID == r.PathValue("id") {
+			return webhook
+		}
+	}
+	w.WriteHeader(http.StatusNotFound)
+	return nil
+}
+
+func createTestWebhook(w http.ResponseWriter, r *http.Request) {
+	webhookURL := r.FormValue("webhook[url]")
+	if webhookURL == "" {
+		writeTestProcessingError(w, "WEBHOOK.INVALID_PARAMS", "url is required")
+		return
+	}
+	webhook := &Webhook{
+		ID:     fmt.Sprintf("hook_%d", len(testWebhooks)+1),
+		Object: RecordTypeWebhook,
+		Mode:   "test",
+		URL:    webhookURL,
+	}
+	testWebhooks = append(testWebhooks, webhook)
+	writeTestJSON(w, http.StatusCreated, webhook)
+}
+
+func listTestWebhooks(w http.ResponseWriter, r *http.Request) {
+	writeTestJSON(w, http.StatusOK, map[string][]*Webhook{"webhooks": testWebhooks})
+}
+
+func getTestWebhook(w http.ResponseWriter, r *http.Request) {
+	if webhook := findTestWebhook(w, r); webhook != nil {
+		writeTestJSON(w, http.StatusOK, webhook)
+	}
+}
+
+func updateTestWebhook(w http.ResponseWriter, r *http.Request) {
+	if webhook := findTestWebhook(w, r); webhook != nil {
+		if r.FormValue("webhook[url]") != "" {
+			writeTestProcessingError(w, "WEBHOOK.INVALID_PARAMS", "url can't be updated")
+			return
+		}
+		webhook.DisabledAt = nil
+		writeTestJSON(w, http.StatusOK, webhook)
+	}
+}
+
+func deleteTestWebhook(w http.ResponseWriter, r *http.Request) {
+	if webhook := findTestWebhook(w, r); webhook != nil {
+		testWebhooks = slices.DeleteFunc(testWebhooks, func(other *Webhook) bool {
+			return other == webhook
+		})
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// testEvents returns tracker.updated events of testTrackers, from the newest
+// to the oldest one.
+func testEvents() []Event {
+	var events []Event
+	for _, tracker := range testTrackers() {
+		result, err := json.Marshal(tracker)
+		if err != nil {
+			panic(err)
+		}
+		events = append(events, Event{
+			Object:      RecordTypeEvent,
+			ID:          strings.Replace(tracker.ID, "trk_", "evt_", 1),
+			Mode:        "test",
+			Description: EventTrackerUpdated,
+			Result:      result,
+			Status:      EventStatusFailed,
+			PendingURLs: []string{"https://example.com/webhook"},
+			CreatedAt:   tracker.CreatedAt.Time,
+			UpdatedAt:   tracker.UpdatedAt.Time,
+		})
+	}
+	return events
+}
+
+func listTestEvents(w http.ResponseWriter, r *http.Request) {
+	page, hasMore, err := testPage(r, testEvents(), func(event Event) (string, time.Time, bool) {
+		return event.ID, event.CreatedAt, true
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	writeTestJSON(w, http.StatusOK, EventList{Events: page, HasMore: hasMore})
+}
+
+func findTestEvent(w http.ResponseWriter, r *http.Request) *Event {
+	for _, event := range testEvents() {
+		if event.ID == r.PathValue("id") {
+			return &event
+		}
+	}
+	w.WriteHeader(http.StatusNotFound)
+	return nil
+}
+
+func getTestEvent(w http.ResponseWriter, r *http.Request) {
+	if event := findTestEvent(w, r); event != nil {
+		writeTestJSON(w, http.StatusOK, event)
+	}
+}
+
+func testEventPayload(event *Event) Payload {
+	body, err := json.Marshal(event)
+	if err != nil {
+		panic(err)
+	}
+	return Payload{
+		ID:           strings.Replace(event.ID, "evt_", "payload_", 1),
+		Object:       RecordTypePayload,
+		RequestURL:   event.PendingURLs[0],
+		RequestBody:  string(body),
+		ResponseCode: http.StatusServiceUnavailable,
+		TotalTime:    30000,
+		CreatedAt:    DateTime{event.CreatedAt},
+		UpdatedAt:    DateTime{event.UpdatedAt},
+	}
+}
+
+func listTestEventPayloads(w http.ResponseWriter, r *http.Request) {
+	if event := findTestEvent(w, r); event != nil {
+		writeTestJSON(w, http.StatusOK, map[string][]Payload{"payloads": {testEventPayload(event)}})
+	}
+}
+
+func getTestEventPayload(w http.ResponseWriter, r *http.Request) {
+	if event := findTestEvent(w, r); event != nil {
+		payload := testEventPayload(event)
+		if payload.ID != r.PathValue("payload_id") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		writeTestJSON(w, http.StatusOK, payload)
+	}
+}
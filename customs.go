@@ -0,0 +1,250 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypost
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+)
+
+type CustomsContentsType string
+
+const (
+	CustomsContentsDocuments            CustomsContentsType = "documents"
+	CustomsContentsGift                 CustomsContentsType = "gift"
+	CustomsContentsMerchandise          CustomsContentsType = "merchandise"
+	CustomsContentsReturnedGoods        CustomsContentsType = "returned_goods"
+	CustomsContentsSample               CustomsContentsType = "sample"
+	CustomsContentsDangerousGoods       CustomsContentsType = "dangerous_goods"
+	CustomsContentsHumanitarianDonation CustomsContentsType = "humanitarian_donation"
+	CustomsContentsOther                CustomsContentsType = "other"
+)
+
+const (
+	// EELPFCNoEEIUnder2500 is the exemption used for shipments from US
+	// valued less than $2500 per tariff number.
+	EELPFCNoEEIUnder2500 = "NOEEI 30.37(a)"
+	// EELPFCNoEEICanada is the exemption used for shipments from US to
+	// Canada.
+	EELPFCNoEEICanada = "NOEEI 30.36"
+)
+
+var (
+	hsTariffNumberPattern = regexp.MustCompile(`^[0-9]{6,10}$`)
+	countryCodePattern    = regexp.MustCompile(`^[A-Z]{2}$`)
+)
+
+// CustomsInfo is the customs declaration required for international
+// shipments.
+type CustomsInfo struct {
+	ID                  string              `json:"id"`
+	Object              RecordType          `json:"object"`
+	Mode                string              `json:"mode"`
+	ContentsType        CustomsContentsType `json:"contents_type"`
+	ContentsExplanation string              `json:"contents_explanation"`
+	CustomsCertify      bool                `json:"customs_certify"`
+	CustomsSigner       string              `json:"customs_signer"`
+	EELPFC              string              `json:"eel_pfc"`
+	NonDeliveryOption   string              `json:"non_delivery_option"`
+	RestrictionType     string              `json:"restriction_type"`
+	RestrictionComments string              `json:"restriction_comments"`
+	Declaration         string              `json:"declaration"`
+	CustomsItems        []CustomsItem       `json:"customs_items"`
+	CreatedAt           DateTime            `json:"created_at"`
+	UpdatedAt           DateTime            `json:"updated_at"`
+}
+
+// CustomsItem describes a type of goods in the shipment. Value is the total
+// value of Quantity items.
+type CustomsItem struct {
+	ID             string     `json:"id"`
+	Object         RecordType `json:"object"`
+	Mode           string     `json:"mode"`
+	Description    string     `json:"description"`
+	Quantity       int        `json:"quantity"`
+	Value          string     `json:"value"`
+	Weight         Weight     `json:"weight"`
+	HSTariffNumber string     `json:"hs_tariff_number"`
+	Code           string     `json:"code"`
+	OriginCountry  string     `json:"origin_country"`
+	Currency       string     `json:"currency"`
+	CreatedAt      DateTime   `json:"created_at"`
+	UpdatedAt      DateTime   `json:"updated_at"`
+}
+
+// Validate checks fields required by EasyPost and validates all items which
+// aren't created yet.
+func (i CustomsInfo) Validate() error {
+	switch i.ContentsType {
+	case "":
+		return InvalidCustomsError{Field: "contents_type", Message: "is required"}
+	case CustomsContentsOther:
+		if i.ContentsExplanation == "" {
+			return InvalidCustomsError{Field: "contents_explanation", Message: "is required for other contents"}
+		}
+	case CustomsContentsDocuments, CustomsContentsGift, CustomsContentsMerchandise, CustomsContentsReturnedGoods,
+		CustomsContentsSample, CustomsContentsDangerousGoods, CustomsContentsHumanitarianDonation:
+	default:
+		return InvalidCustomsError{Field: "contents_type", Message: fmt.Sprintf("unknown contents type %s", i.ContentsType)}
+	}
+	if i.EELPFC == "" {
+		return InvalidCustomsError{Field: "eel_pfc", Message: "is required"}
+	}
+	if len(i.CustomsItems) == 0 {
+		return InvalidCustomsError{Field: "customs_items", Message: "at least one item is required"}
+	}
+	for n, item := range i.CustomsItems {
+		if item.ID != "" {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			e := err.(InvalidCustomsError)
+			e.Field = fmt.Sprintf("customs_items[%d].%s", n, e.Field)
+			return e
+		}
+	}
+	return nil
+}
+
+// Validate checks fields required by EasyPost.
+func (i CustomsItem) Validate() error {
+	if i.Description == "" {
+		return InvalidCustomsError{Field: "description", Message: "is required"}
+	}
+	if i.Quantity <= 0 {
+		return InvalidCustomsError{Field: "quantity", Message: "must be positive"}
+	}
+	if value, err := strconv.ParseFloat(i.Value, 64); err != nil || value < 0 {
+		return InvalidCustomsError{Field: "value", Message: fmt.Sprintf("invalid value %q", i.Value)}
+	}
+	if i.Weight <= 0 {
+		return InvalidCustomsError{Field: "weight", Message: "must be positive"}
+	}
+	if !hsTariffNumberPattern.MatchString(i.HSTariffNumber) {
+		return InvalidCustomsError{Field: "hs_tariff_number", Message: fmt.Sprintf("invalid tariff number %q, 6 to 10 digits expected", i.HSTariffNumber)}
+	}
+	if !countryCodePattern.MatchString(i.OriginCountry) {
+		return InvalidCustomsError{Field: "origin_country", Message: fmt.Sprintf("invalid country %q, 2 character ISO code expected", i.OriginCountry)}
+	}
+	return nil
+}
+
+// encode sets customs info fields as parameters nested under prefix.
+// Customs infos already created in EasyPost are referred by their ID only.
+func (i CustomsInfo) encode(prefix string, parameters url.Values) {
+	if i.ID != "" {
+		parameters.Set(prefix+"[id]", i.ID)
+		return
+	}
+	parameters.Set(prefix+"[contents_type]", string(i.ContentsType))
+	parameters.Set(prefix+"[eel_pfc]", i.EELPFC)
+	parameters.Set(prefix+"[customs_certify]", strconv.FormatBool(i.CustomsCertify))
+	for name, value := range map[string]string{
+		"contents_explanation": i.ContentsExplanation,
+		"customs_signer":       i.CustomsSigner,
+		"non_delivery_option":  i.NonDeliveryOption,
+		"restriction_type":     i.RestrictionType,
+		"restriction_comments": i.RestrictionComments,
+		"declaration":          i.Declaration,
+	} {
+		if value != "" {
+			parameters.Set(prefix+"["+name+"]", value)
+		}
+	}
+	for n, item := range i.CustomsItems {
+		item.encode(fmt.Sprintf("%s[customs_items][%d]", prefix, n), parameters)
+	}
+}
+
+// encode sets customs item fields as parameters nested under prefix.
+// Customs items already created in EasyPost are referred by their ID only.
+func (i CustomsItem) encode(prefix string, parameters url.Values) {
+	if i.ID != "" {
+		parameters.Set(prefix+"[id]", i.ID)
+		return
+	}
+	parameters.Set(prefix+"[description]", i.Description)
+	parameters.Set(prefix+"[quantity]", strconv.Itoa(i.Quantity))
+	parameters.Set(prefix+"[value]", i.Value)
+	parameters.Set(prefix+"[weight]", strconv.FormatFloat(i.Weight.Ounces(), 'f', -1, 64))
+	parameters.Set(prefix+"[hs_tariff_number]", i.HSTariffNumber)
+	parameters.Set(prefix+"[origin_country]", i.OriginCountry)
+	if i.Code != "" {
+		parameters.Set(prefix+"[code]", i.Code)
+	}
+	if i.Currency != "" {
+		parameters.Set(prefix+"[currency]", i.Currency)
+	}
+}
+
+// CreateCustomsInfo validates and creates the customs info along with its
+// items.
+func (c *Client) CreateCustomsInfo(info CustomsInfo) (*CustomsInfo, error) {
+	return c.CreateCustomsInfoContext(context.Background(), info)
+}
+
+func (c *Client) CreateCustomsInfoContext(ctx context.Context, info CustomsInfo) (*CustomsInfo, error) {
+	if err := info.Validate(); err != nil {
+		return nil, err
+	}
+	parameters := url.Values{}
+	info.encode("customs_info", parameters)
+
+	responseBody, err := c.post(ctx, customsInfoURL, parameters)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[CustomsInfo](responseBody)
+}
+
+func (c *Client) GetCustomsInfo(id string) (*CustomsInfo, error) {
+	return c.GetCustomsInfoContext(context.Background(), id)
+}
+
+func (c *Client) GetCustomsInfoContext(ctx context.Context, id string) (*CustomsInfo, error) {
+	responseBody, err := c.get(ctx, objectPath(customsInfoURL, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[CustomsInfo](responseBody)
+}
+
+// CreateCustomsItem validates and creates the customs item.
+func (c *Client) CreateCustomsItem(item CustomsItem) (*CustomsItem, error) {
+	return c.CreateCustomsItemContext(context.Background(), item)
+}
+
+func (c *Client) CreateCustomsItemContext(ctx context.Context, item CustomsItem) (*CustomsItem, error) {
+	if err := item.Validate(); err != nil {
+		return nil, err
+	}
+	parameters := url.Values{}
+	item.encode("customs_item", parameters)
+
+	responseBody, err := c.post(ctx, customsItemURL, parameters)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[CustomsItem](responseBody)
+}
+
+func (c *Client) GetCustomsItem(id string) (*CustomsItem, error) {
+	return c.GetCustomsItemContext(context.Background(), id)
+}
+
+func (c *Client) GetCustomsItemContext(ctx context.Context, id string) (*CustomsItem, error) {
+	responseBody, err := c.get(ctx, objectPath(customsItemURL, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[CustomsItem](responseBody)
+}
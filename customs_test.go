@@ -0,0 +1,162 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypost
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func testCustomsItem() CustomsItem {
+	return CustomsItem{
+		Description:    "T-shirt",
+		Quantity:       2,
+		Value:          "23.25",
+		Weight:         Ounces(10.5),
+		HSTariffNumber: "610910",
+		OriginCountry:  "US",
+	}
+}
+
+func testCustomsInfo() CustomsInfo {
+	return CustomsInfo{
+		ContentsType:   CustomsContentsMerchandise,
+		CustomsCertify: true,
+		CustomsSigner:  "Steve Brule",
+		EELPFC:         EELPFCNoEEIUnder2500,
+		CustomsItems:   []CustomsItem{testCustomsItem()},
+	}
+}
+
+func TestCustomsInfoValidate(t *testing.T) {
+	for _, test := range []struct {
+		name          string
+		modify        func(i *CustomsInfo)
+		expectedField string
+	}{
+		{
+			name:   "valid",
+			modify: func(i *CustomsInfo) {},
+		},
+		{
+			name:   "created item",
+			modify: func(i *CustomsInfo) { i.CustomsItems = []CustomsItem{{ID: "cstitem_1"}} },
+		},
+		{
+			name:          "missing contents type",
+			modify:        func(i *CustomsInfo) { i.ContentsType = "" },
+			expectedField: "contents_type",
+		},
+		{
+			name:          "unknown contents type",
+			modify:        func(i *CustomsInfo) { i.ContentsType = "toys" },
+			expectedField: "contents_type",
+		},
+		{
+			name:          "other contents without explanation",
+			modify:        func(i *CustomsInfo) { i.ContentsType = CustomsContentsOther },
+			expectedField: "contents_explanation",
+		},
+		{
+			name:          "missing eel_pfc",
+			modify:        func(i *CustomsInfo) { i.EELPFC = "" },
+			expectedField: "eel_pfc",
+		},
+		{
+			name:          "missing items",
+			modify:        func(i *CustomsInfo) { i.CustomsItems = nil },
+			expectedField: "customs_items",
+		},
+		{
+			name:          "invalid tariff number",
+			modify:        func(i *CustomsInfo) { i.CustomsItems[0].HSTariffNumber = "6109.10" },
+			expectedField: "customs_items[0].hs_tariff_number",
+		},
+		{
+			name:          "invalid origin country",
+			modify:        func(i *CustomsInfo) { i.CustomsItems[0].OriginCountry = "USA" },
+			expectedField: "customs_items[0].origin_country",
+		},
+		{
+			name:          "invalid value",
+			modify:        func(i *CustomsInfo) { i.CustomsItems[0].Value = "" },
+			expectedField: "customs_items[0].value",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			info := testCustomsInfo()
+			test.modify(&info)
+			err := info.Validate()
+			if test.expectedField == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			customsError, ok := err.(InvalidCustomsError)
+			if !ok {
+				t.Fatalf("expected InvalidCustomsError, got: %T(%v)", err, err)
+			}
+			if customsError.Field != test.expectedField {
+				t.Fatalf("unexpected field, expected: %s, got: %s", test.expectedField, customsError.Field)
+			}
+		})
+	}
+}
+
+func TestCreateCustomsInfo(t *testing.T) {
+	setup()
+
+	b, err := readTestCustomsFile("cstinfo_1")
+	if err != nil {
+		t.Fatalf("error reading file: %s", err)
+	}
+	expectedInfo := CustomsInfo{}
+	if err := json.Unmarshal(b, &expectedInfo); err != nil {
+		t.Fatalf("expected customs info build error: %s", err)
+	}
+
+	info, err := testClient.CreateCustomsInfo(testCustomsInfo())
+	if err != nil {
+		t.Fatalf("not success response: %s", err)
+	}
+	if !reflect.DeepEqual(info, &expectedInfo) {
+		t.Fatalf("customs infos: \nexpected %+v\n     got %+v", &expectedInfo, info)
+	}
+
+	info, err = testClient.GetCustomsInfo("cstinfo_1")
+	if err != nil {
+		t.Fatalf("not success response: %s", err)
+	}
+	if !reflect.DeepEqual(info, &expectedInfo) {
+		t.Fatalf("customs infos: \nexpected %+v\n     got %+v", &expectedInfo, info)
+	}
+
+	item, err := testClient.CreateCustomsItem(testCustomsItem())
+	if err != nil {
+		t.Fatalf("not success response: %s", err)
+	}
+	if !reflect.DeepEqual(*item, expectedInfo.CustomsItems[0]) {
+		t.Fatalf("customs items: \nexpected %+v\n     got %+v", expectedInfo.CustomsItems[0], *item)
+	}
+
+	item, err = testClient.GetCustomsItem("cstitem_1")
+	if err != nil {
+		t.Fatalf("not success response: %s", err)
+	}
+	if !reflect.DeepEqual(*item, expectedInfo.CustomsItems[0]) {
+		t.Fatalf("customs items: \nexpected %+v\n     got %+v", expectedInfo.CustomsItems[0], *item)
+	}
+
+	_, err = testClient.CreateShipment(Shipment{CustomsInfo: &CustomsInfo{ContentsType: CustomsContentsGift}})
+	if _, ok := err.(InvalidCustomsError); !ok {
+		t.Fatalf("expected InvalidCustomsError, got: %T(%s)", err, err)
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+module github.com/retailnext/easypost/easypostotel
+
+go 1.25.0
+
+// The module needs easypost.ErrorCodeOf and easypost.OperationMiddleware,
+// which aren't in a tagged release yet, so it is built with the easypost
+// package of this repository. Once the root module is tagged, require that
+// release and drop the replace directive before tagging this module.
+replace github.com/retailnext/easypost => ../
+
+require (
+	github.com/retailnext/easypost v0.0.0-00010101000000-000000000000
+	go.opentelemetry.io/otel v1.44.0
+	go.opentelemetry.io/otel/metric v1.44.0
+	go.opentelemetry.io/otel/sdk v1.44.0
+	go.opentelemetry.io/otel/sdk/metric v1.44.0
+	go.opentelemetry.io/otel/trace v1.44.0
+)
+
+require (
+	github.com/cespare/xxhash/v2 v2.3.0 // indirect
+	github.com/go-logr/logr v1.4.3 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	github.com/google/uuid v1.6.0 // indirect
+	go.opentelemetry.io/auto/sdk v1.2.1 // indirect
+	golang.org/x/sys v0.45.0 // indirect
+)
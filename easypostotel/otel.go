@@ -0,0 +1,233 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package easypostotel instruments easypost.Client with OpenTelemetry
+// traces and metrics:
+//
+//	c := easypost.NewClient(apiKey,
+//		easypost.WithOperationMiddleware(easypostotel.OperationMiddleware()),
+//		easypost.WithMiddleware(easypostotel.Middleware()))
+package easypostotel
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/retailnext/easypost"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/retailnext/easypost/easypostotel"
+
+// Attribute keys of spans and metrics.
+const (
+	EndpointKey   = attribute.Key("easypost.endpoint")
+	RequestIDKey  = attribute.Key("easypost.request_id")
+	ErrorCodeKey  = attribute.Key("easypost.error_code")
+	AttemptKey    = attribute.Key("easypost.attempt")
+	MethodKey     = attribute.Key("http.request.method")
+	StatusCodeKey = attribute.Key("http.response.status_code")
+)
+
+// Option configures the middlewares created by Middleware and
+// OperationMiddleware.
+type Option func(*config)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+}
+
+// WithTracerProvider sets the provider of the tracer, the global one is used
+// by default.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = tp
+	}
+}
+
+// WithMeterProvider sets the provider of the meter, the global one is used
+// by default.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = mp
+	}
+}
+
+type instrumentation struct {
+	tracer            trace.Tracer
+	requests          metric.Int64Counter
+	duration          metric.Float64Histogram
+	retries           metric.Int64Counter
+	operationDuration metric.Float64Histogram
+}
+
+func newInstrumentation(options []Option) instrumentation {
+	c := config{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+	}
+	for _, option := range options {
+		option(&c)
+	}
+
+	meter := c.meterProvider.Meter(instrumentationName)
+	i := instrumentation{
+		tracer: c.tracerProvider.Tracer(instrumentationName),
+	}
+	var err error
+	i.requests, err = meter.Int64Counter("easypost.client.requests",
+		metric.WithDescription("Number of EasyPost API requests, including retries."),
+		metric.WithUnit("{request}"))
+	if err != nil {
+		otel.Handle(err)
+	}
+	i.duration, err = meter.Float64Histogram("easypost.client.request.duration",
+		metric.WithDescription("Duration of single attempts of EasyPost API requests."),
+		metric.WithUnit("s"))
+	if err != nil {
+		otel.Handle(err)
+	}
+	i.retries, err = meter.Int64Counter("easypost.client.retries",
+		metric.WithDescription("Number of retried EasyPost API requests."),
+		metric.WithUnit("{request}"))
+	if err != nil {
+		otel.Handle(err)
+	}
+	i.operationDuration, err = meter.Float64Histogram("easypost.client.operation.duration",
+		metric.WithDescription("Duration of EasyPost API calls, including retries, backoff and rate limiter waits."),
+		metric.WithUnit("s"))
+	if err != nil {
+		otel.Handle(err)
+	}
+	return i
+}
+
+// Middleware returns an easypost.Middleware which emits a client span for
+// every attempt of an API request and records easypost.client.requests,
+// easypost.client.request.duration and easypost.client.retries metrics.
+// Spans and metrics have the endpoint, e.g. "trackers/{id}", the status code
+// and the EasyPost error code as attributes. Attempt spans are children of
+// the span of OperationMiddleware if it is used too.
+func Middleware(options ...Option) easypost.Middleware {
+	i := newInstrumentation(options)
+	return func(next easypost.Handler) easypost.Handler {
+		return func(r *http.Request) (*easypost.Response, error) {
+			return i.handle(next, r)
+		}
+	}
+}
+
+// OperationMiddleware returns an easypost.OperationMiddleware which emits a
+// span for every API call, covering all its attempts, and records the
+// easypost.client.operation.duration metric, with the same attributes as
+// Middleware has for the last attempt.
+func OperationMiddleware(options ...Option) easypost.OperationMiddleware {
+	i := newInstrumentation(options)
+	return func(next easypost.OperationHandler) easypost.OperationHandler {
+		return func(ctx context.Context, op easypost.Operation) (*easypost.Response, error) {
+			return i.handleOperation(ctx, next, op)
+		}
+	}
+}
+
+func (i instrumentation) handle(next easypost.Handler, r *http.Request) (*easypost.Response, error) {
+	endpoint := Endpoint(r.URL.Path)
+	attempt := easypost.RequestAttempt(r)
+	ctx, span := i.tracer.Start(r.Context(), "easypost "+r.Method+" "+endpoint,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			MethodKey.String(r.Method),
+			EndpointKey.String(endpoint),
+			AttemptKey.Int(attempt),
+		))
+	defer span.End()
+
+	start := time.Now()
+	response, err := next(r.WithContext(ctx))
+	d := time.Since(start)
+
+	attrs := setOutcome(span, r.Method, endpoint, response, err)
+	set := metric.WithAttributes(attrs...)
+	i.requests.Add(ctx, 1, set)
+	i.duration.Record(ctx, d.Seconds(), set)
+	if attempt > 1 {
+		i.retries.Add(ctx, 1, metric.WithAttributes(attrs[:2]...))
+	}
+	return response, err
+}
+
+func (i instrumentation) handleOperation(ctx context.Context, next easypost.OperationHandler, op easypost.Operation) (*easypost.Response, error) {
+	endpoint := Endpoint(op.Path)
+	ctx, span := i.tracer.Start(ctx, "easypost "+op.Method+" "+endpoint,
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(
+			MethodKey.String(op.Method),
+			EndpointKey.String(endpoint),
+		))
+	defer span.End()
+
+	start := time.Now()
+	response, err := next(ctx, op)
+	d := time.Since(start)
+
+	attrs := setOutcome(span, op.Method, endpoint, response, err)
+	i.operationDuration.Record(ctx, d.Seconds(), metric.WithAttributes(attrs...))
+	return response, err
+}
+
+// setOutcome sets the outcome of a request on span and returns the metric
+// attributes of the request, starting with the method and the endpoint.
+func setOutcome(span trace.Span, method, endpoint string, response *easypost.Response, err error) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{
+		MethodKey.String(method),
+		EndpointKey.String(endpoint),
+	}
+	if response != nil {
+		attrs = append(attrs, StatusCodeKey.Int(response.StatusCode))
+		span.SetAttributes(
+			StatusCodeKey.Int(response.StatusCode),
+			RequestIDKey.String(response.RequestID),
+		)
+	}
+	if err != nil {
+		if code := easypost.ErrorCodeOf(err); code != "" {
+			attrs = append(attrs, ErrorCodeKey.String(string(code)))
+			span.SetAttributes(ErrorCodeKey.String(string(code)))
+		}
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return attrs
+}
+
+// objectID matches EasyPost object IDs, e.g. trk_0123456789abcdef.
+var objectID = regexp.MustCompile(`^[a-z]+_[0-9a-zA-Z]{8,}$`)
+
+// Endpoint returns the API path without the version prefix and with object
+// IDs replaced by "{id}", so it has low cardinality, e.g.
+// "/v2/shipments/shp_0123456789/buy" becomes "shipments/{id}/buy".
+func Endpoint(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) > 0 && len(segments[0]) > 1 && segments[0][0] == 'v' && strings.Trim(segments[0][1:], "0123456789") == "" {
+		segments = segments[1:]
+	}
+	for i, segment := range segments {
+		if objectID.MatchString(segment) {
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
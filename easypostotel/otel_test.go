@@ -0,0 +1,187 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypostotel
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/retailnext/easypost"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestMiddleware(t *testing.T) {
+	requests := 0
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"error": {"code": "SERVICE.UNAVAILABLE", "message": "unavailable"}}`))
+			return
+		}
+		w.Header().Set("X-Ep-Request-Uuid", "req-2")
+		w.Write([]byte(`{"id": "trk_0123456789abcdef", "object": "Tracker"}`))
+	}))
+	defer s.Close()
+
+	spans := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+	retryPolicy := easypost.DefaultRetryPolicy()
+	retryPolicy.InitialBackoff = time.Millisecond
+	options := []Option{
+		WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))),
+		WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+	}
+	c := easypost.NewClient("", easypost.WithBaseURL(s.URL), easypost.WithRetryPolicy(retryPolicy),
+		easypost.WithOperationMiddleware(OperationMiddleware(options...)),
+		easypost.WithMiddleware(Middleware(options...)))
+
+	if _, err := c.RetrieveTracker("trk_0123456789abcdef"); err != nil {
+		t.Fatal(err)
+	}
+
+	ended := spans.Ended()
+	if len(ended) != 3 {
+		t.Fatalf("unexpected number of spans, expected: 3, got: %d", len(ended))
+	}
+	operation := ended[2]
+	if operation.Name() != "easypost GET trackers/{id}" || operation.SpanKind() != trace.SpanKindInternal {
+		t.Fatalf("unexpected operation span: %s %v", operation.Name(), operation.SpanKind())
+	}
+	if operation.Status().Code != codes.Unset {
+		t.Fatalf("unexpected operation status: %v", operation.Status())
+	}
+	operationAttrs := attribute.NewSet(operation.Attributes()...)
+	if v, _ := operationAttrs.Value(RequestIDKey); v.AsString() != "req-2" {
+		t.Fatalf("unexpected operation request ID: %v", v.Emit())
+	}
+	for i, expected := range []struct {
+		status    int
+		code      string
+		spanCode  codes.Code
+		requestID string
+	}{
+		{status: http.StatusServiceUnavailable, code: "SERVICE.UNAVAILABLE", spanCode: codes.Error},
+		{status: http.StatusOK, spanCode: codes.Unset, requestID: "req-2"},
+	} {
+		span := ended[i]
+		if span.Name() != "easypost GET trackers/{id}" {
+			t.Fatalf("span %d: unexpected name: %s", i, span.Name())
+		}
+		if span.Parent().SpanID() != operation.SpanContext().SpanID() {
+			t.Fatalf("span %d: operation span isn't the parent", i)
+		}
+		if span.Status().Code != expected.spanCode {
+			t.Fatalf("span %d: unexpected status: %v", i, span.Status())
+		}
+		attrs := attribute.NewSet(span.Attributes()...)
+		for key, value := range map[attribute.Key]attribute.Value{
+			EndpointKey:   attribute.StringValue("trackers/{id}"),
+			AttemptKey:    attribute.IntValue(i + 1),
+			StatusCodeKey: attribute.IntValue(expected.status),
+			RequestIDKey:  attribute.StringValue(expected.requestID),
+		} {
+			if v, _ := attrs.Value(key); v != value {
+				t.Fatalf("span %d: unexpected %s: %v", i, key, v.Emit())
+			}
+		}
+		if v, ok := attrs.Value(ErrorCodeKey); ok != (expected.code != "") || v.AsString() != expected.code {
+			t.Fatalf("span %d: unexpected error code: %v", i, v.Emit())
+		}
+	}
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatal(err)
+	}
+	sums := map[string]int64{}
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		switch data := m.Data.(type) {
+		case metricdata.Sum[int64]:
+			for _, point := range data.DataPoints {
+				sums[m.Name] += point.Value
+			}
+		case metricdata.Histogram[float64]:
+			for _, point := range data.DataPoints {
+				sums[m.Name] += int64(point.Count)
+			}
+		}
+	}
+	for name, expected := range map[string]int64{
+		"easypost.client.requests":           2,
+		"easypost.client.request.duration":   2,
+		"easypost.client.retries":            1,
+		"easypost.client.operation.duration": 1,
+	} {
+		if sums[name] != expected {
+			t.Fatalf("unexpected %s, expected: %d, got: %d", name, expected, sums[name])
+		}
+	}
+
+	// Transport errors have the request URL, spans must not have its query.
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closed.Close()
+	spans = tracetest.NewSpanRecorder()
+	options = []Option{
+		WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))),
+		WithMeterProvider(sdkmetric.NewMeterProvider()),
+	}
+	c = easypost.NewClient("", easypost.WithBaseURL(closed.URL),
+		easypost.WithOperationMiddleware(OperationMiddleware(options...)),
+		easypost.WithMiddleware(Middleware(options...)))
+	if _, err := c.ListTrackers(easypost.TrackerListParams{TrackingCode: "EZ3000000003"}); err == nil {
+		t.Fatal("error expected")
+	}
+	ended = spans.Ended()
+	if len(ended) != 2 {
+		t.Fatalf("unexpected number of spans, expected: 2, got: %d", len(ended))
+	}
+	for i, span := range ended {
+		if span.Status().Code != codes.Error || span.Status().Description == "" {
+			t.Fatalf("span %d: unexpected status: %v", i, span.Status())
+		}
+		texts := []string{span.Status().Description}
+		for _, event := range span.Events() {
+			for _, attr := range event.Attributes {
+				texts = append(texts, attr.Value.Emit())
+			}
+		}
+		if len(texts) == 1 {
+			t.Fatalf("span %d: error event expected", i)
+		}
+		for _, text := range texts {
+			if strings.Contains(text, "?") || strings.Contains(text, "EZ3000000003") {
+				t.Fatalf("span %d: request parameters in span: %s", i, text)
+			}
+		}
+	}
+}
+
+func TestEndpoint(t *testing.T) {
+	for path, expected := range map[string]string{
+		"/v2/trackers":                         "trackers",
+		"/v2/trackers/create_list":             "trackers/create_list",
+		"/v2/shipments/shp_0123456789abcd/buy": "shipments/{id}/buy",
+		"/addresses/adr_0123456789abcd":        "addresses/{id}",
+	} {
+		if endpoint := Endpoint(path); endpoint != expected {
+			t.Fatalf("%s: unexpected endpoint, expected: %s, got: %s", path, expected, endpoint)
+		}
+	}
+}
@@ -10,7 +10,11 @@ package easypost
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 )
 
 var (
@@ -18,21 +22,51 @@ var (
 	unauthorizedError UnauthorizedError
 )
 
+// ErrorCode is the code of EasyPost error. It can be used as the target of
+// errors.Is to check the code of ProcessingError.
 type ErrorCode string
 
-type UnauthorizedError struct{}
+func (c ErrorCode) Error() string {
+	return string(c)
+}
+
+// ErrorCodeOf returns the EasyPost code of err, or "" if err doesn't have
+// one.
+func ErrorCodeOf(err error) ErrorCode {
+	var (
+		processingError ProcessingError
+		rateLimitError  RateLimitError
+		serverError     ServerError
+	)
+	switch {
+	case errors.As(err, &processingError):
+		return processingError.Code()
+	case errors.As(err, &rateLimitError):
+		return rateLimitError.Code
+	case errors.As(err, &serverError):
+		return serverError.Code
+	}
+	return ""
+}
+
+type UnauthorizedError struct {
+	ResponseInfo
+}
 
 func (e UnauthorizedError) Error() string {
 	return "unauthorized error"
 }
 
-type PaymentRequiredError struct{}
+type PaymentRequiredError struct {
+	ResponseInfo
+}
 
 func (e PaymentRequiredError) Error() string {
 	return "payment required"
 }
 
 type ProcessingError struct {
+	ResponseInfo
 	code    string
 	msg     string
 	details json.RawMessage
@@ -42,19 +76,144 @@ func (e ProcessingError) Error() string {
 	return e.msg
 }
 
+func (e ProcessingError) Code() ErrorCode {
+	return ErrorCode(e.code)
+}
+
+// Is reports whether target is the ErrorCode of e or of any of its field
+// errors.
+func (e ProcessingError) Is(target error) bool {
+	code, ok := target.(ErrorCode)
+	if !ok || code == "" {
+		return false
+	}
+	return code == e.Code() || slices.ContainsFunc(e.FieldErrors(), func(fieldError FieldError) bool {
+		return fieldError.Code == code
+	})
+}
+
+// FieldErrors returns errors of the request parameters. EasyPost reports them
+// either as objects or as plain messages, the latter are returned as field
+// errors with Message only. Entries of unknown format are skipped.
+func (e ProcessingError) FieldErrors() []FieldError {
+	var entries []json.RawMessage
+	if err := json.Unmarshal(e.details, &entries); err != nil {
+		return nil
+	}
+	var fieldErrors []FieldError
+	for _, entry := range entries {
+		var message string
+		if err := json.Unmarshal(entry, &message); err == nil {
+			fieldErrors = append(fieldErrors, FieldError{Message: message})
+			continue
+		}
+		var fieldError FieldError
+		if err := json.Unmarshal(entry, &fieldError); err == nil {
+			fieldErrors = append(fieldErrors, fieldError)
+		}
+	}
+	return fieldErrors
+}
+
+// AddressFieldErrors groups field errors by the name of the Address field
+// they refer to, e.g. "Street1". Errors which don't refer to a single field
+// of Address are grouped under "".
+func (e ProcessingError) AddressFieldErrors() map[string][]FieldError {
+	fieldErrors := e.FieldErrors()
+	if len(fieldErrors) == 0 {
+		return nil
+	}
+	grouped := map[string][]FieldError{}
+	for _, fieldError := range fieldErrors {
+		name, _ := AddressFieldName(fieldError.Field)
+		grouped[name] = append(grouped[name], fieldError)
+	}
+	return grouped
+}
+
 func (e ProcessingError) Details(target interface{}) error {
 	return json.Unmarshal(e.details, target)
 }
 
+// NotFoundError is returned when the requested object doesn't exist.
+type NotFoundError struct {
+	ResponseInfo
+}
+
+func (e NotFoundError) Error() string {
+	return "resource is not reachable"
+}
+
+// RateLimitError is returned when EasyPost throttles requests. RetryAfter is
+// the time to wait before the next request, if the server provided it.
+type RateLimitError struct {
+	ResponseInfo
+	Code    ErrorCode
+	Message string
+}
+
+func (e RateLimitError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("rate limit exceeded, retry after %s", e.RetryAfter)
+	}
+	return "rate limit exceeded"
+}
+
+// Is reports whether target is the ErrorCode of e.
+func (e RateLimitError) Is(target error) bool {
+	code, ok := target.(ErrorCode)
+	return ok && code != "" && code == e.Code
+}
+
+// ServerError is returned when EasyPost fails to process the request because
+// of an internal failure.
+type ServerError struct {
+	ResponseInfo
+	Code    ErrorCode
+	Message string
+}
+
+func (e ServerError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("request can't be processed by server: %d %s", e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("request can't be processed by server: %d", e.StatusCode)
+}
+
+// Is reports whether target is the ErrorCode of e.
+func (e ServerError) Is(target error) bool {
+	code, ok := target.(ErrorCode)
+	return ok && code != "" && code == e.Code
+}
+
+// TransportError is returned when the request doesn't reach EasyPost or the
+// response can't be read. The request URL in Err has no query, so request
+// parameters don't leak into logs.
+type TransportError struct {
+	Err error
+}
+
+func (e TransportError) Error() string {
+	return fmt.Sprintf("error sending request: %s", e.Err)
+}
+
+func (e TransportError) Unwrap() error {
+	return e.Err
+}
+
 type errorMessage struct {
 	Code        string          `json:"code"`
 	Message     string          `json:"message"`
 	FieldErrors json.RawMessage `json:"errors"`
 }
 
+// FieldError describes the problem of a single request parameter. Code and
+// Suggestion are set only by some EasyPost errors, e.g. address verification.
 type FieldError struct {
-	Field   string `json:"field"`
-	Message string `json:"message"`
+	Field      string    `json:"field"`
+	Message    string    `json:"message"`
+	Code       ErrorCode `json:"code,omitempty"`
+	Suggestion *string   `json:"suggestion,omitempty"`
 }
 
 type ErrorResponse struct {
@@ -68,3 +227,92 @@ type NotSupportedRecordError struct {
 func (e NotSupportedRecordError) Error() string {
 	return fmt.Sprintf("not supported record: %s", e.recordType)
 }
+
+// RateNotFoundError is returned when none of shipment rates matches the
+// requested carriers, services or delivery date.
+type RateNotFoundError struct {
+	Carriers   []Carrier
+	Services   []string
+	DeliverBy  *time.Time
+	Guaranteed bool
+}
+
+func (e RateNotFoundError) Error() string {
+	var conditions []string
+	if len(e.Carriers) > 0 {
+		conditions = append(conditions, fmt.Sprintf("carriers %v", e.Carriers))
+	}
+	if len(e.Services) > 0 {
+		conditions = append(conditions, fmt.Sprintf("services %v", e.Services))
+	}
+	if e.DeliverBy != nil {
+		guaranteed := ""
+		if e.Guaranteed {
+			guaranteed = "guaranteed "
+		}
+		conditions = append(conditions, fmt.Sprintf("%sdelivery by %s", guaranteed, e.DeliverBy.Format(time.RFC3339)))
+	}
+	if len(conditions) == 0 {
+		return "no rate found"
+	}
+	return fmt.Sprintf("no rate found for %s", strings.Join(conditions, ", "))
+}
+
+// InvalidParcelError is returned when a parcel fails validation before it is
+// sent to EasyPost.
+type InvalidParcelError struct {
+	Field   string
+	Message string
+}
+
+func (e InvalidParcelError) Error() string {
+	return fmt.Sprintf("invalid parcel %s: %s", e.Field, e.Message)
+}
+
+// InvalidCustomsError is returned when a customs info or item fails
+// validation before it is sent to EasyPost.
+type InvalidCustomsError struct {
+	Field   string
+	Message string
+}
+
+func (e InvalidCustomsError) Error() string {
+	return fmt.Sprintf("invalid customs %s: %s", e.Field, e.Message)
+}
+
+// LabelNotAvailableError is returned when a postage label has no URL of the
+// requested format, the label has to be converted first.
+type LabelNotAvailableError struct {
+	format LabelFormat
+}
+
+func (e LabelNotAvailableError) Error() string {
+	return fmt.Sprintf("label is not available in %s format", e.format)
+}
+
+// SignatureError is returned by a WebHookHandler when the signature of the
+// request is missing or doesn't match any of the secrets.
+type SignatureError struct {
+	Reason string
+}
+
+func (e SignatureError) Error() string {
+	return fmt.Sprintf("invalid webhook signature: %s", e.Reason)
+}
+
+// UnexpectedResponseError is returned when EasyPost responds with an error
+// status and a body which isn't an EasyPost error, e.g. an HTML page of a
+// proxy.
+type UnexpectedResponseError struct {
+	ResponseInfo
+	Body []byte
+	Err  error
+}
+
+func (e UnexpectedResponseError) Error() string {
+	return fmt.Sprintf("error parse not success response: %d: %s", e.StatusCode, e.Err)
+}
+
+func (e UnexpectedResponseError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,200 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypost
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestErrorTypes(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		response func(w http.ResponseWriter)
+		check    func(t *testing.T, err error)
+	}{
+		{
+			name: "not found",
+			response: func(w http.ResponseWriter) {
+				w.Header().Set(requestIDHeader, "req-1")
+				w.WriteHeader(http.StatusNotFound)
+			},
+			check: func(t *testing.T, err error) {
+				var notFoundError NotFoundError
+				if !errors.As(err, &notFoundError) || notFoundError.RequestID != "req-1" {
+					t.Fatalf("expected NotFoundError, got: %T(%v)", err, err)
+				}
+			},
+		},
+		{
+			name: "rate limit",
+			response: func(w http.ResponseWriter) {
+				w.Header().Set("Retry-After", "3")
+				w.WriteHeader(http.StatusTooManyRequests)
+			},
+			check: func(t *testing.T, err error) {
+				var rateLimitError RateLimitError
+				if !errors.As(err, &rateLimitError) || rateLimitError.RetryAfter != 3*time.Second {
+					t.Fatalf("expected RateLimitError, got: %T(%v)", err, err)
+				}
+			},
+		},
+		{
+			name: "server error",
+			response: func(w http.ResponseWriter) {
+				w.Header().Set(requestIDHeader, "req-2")
+				w.WriteHeader(http.StatusServiceUnavailable)
+				json.NewEncoder(w).Encode(ErrorResponse{
+					Error: errorMessage{Code: string(AddressVerifyUpstreamUnavailable), Message: "upstream unavailable"},
+				})
+			},
+			check: func(t *testing.T, err error) {
+				expectedError := ServerError{
+					ResponseInfo: ResponseInfo{
+						RequestID:  "req-2",
+						StatusCode: http.StatusServiceUnavailable,
+					},
+					Code:    AddressVerifyUpstreamUnavailable,
+					Message: "upstream unavailable",
+				}
+				var serverError ServerError
+				if errors.As(err, &serverError) {
+					serverError.Latency = 0
+				}
+				if !reflect.DeepEqual(serverError, expectedError) {
+					t.Fatalf("error:\nexpected: %#v \ngot: %#v", expectedError, err)
+				}
+				if !errors.Is(err, AddressVerifyUpstreamUnavailable) {
+					t.Fatalf("expected %s code", AddressVerifyUpstreamUnavailable)
+				}
+			},
+		},
+		{
+			name: "processing error",
+			response: func(w http.ResponseWriter) {
+				writeTestProcessingError(w, AddressNotFound, "address not found")
+			},
+			check: func(t *testing.T, err error) {
+				var processingError ProcessingError
+				if !errors.As(err, &processingError) || processingError.Code() != AddressNotFound {
+					t.Fatalf("expected ProcessingError, got: %T(%v)", err, err)
+				}
+				if !errors.Is(err, AddressNotFound) {
+					t.Fatalf("expected %s code", AddressNotFound)
+				}
+				if errors.Is(err, AddressVerificationFailure) {
+					t.Fatalf("unexpected %s code", AddressVerificationFailure)
+				}
+			},
+		},
+		{
+			name: "unexpected response",
+			response: func(w http.ResponseWriter) {
+				w.Header().Set(requestIDHeader, "req-3")
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				w.Write([]byte("<html>bad gateway</html>"))
+			},
+			check: func(t *testing.T, err error) {
+				var unexpectedError UnexpectedResponseError
+				if !errors.As(err, &unexpectedError) || string(unexpectedError.Body) != "<html>bad gateway</html>" {
+					t.Fatalf("expected UnexpectedResponseError, got: %T(%v)", err, err)
+				}
+				if info, ok := ResponseInfoFromError(err); !ok || info.RequestID != "req-3" || info.StatusCode != http.StatusUnprocessableEntity {
+					t.Fatalf("unexpected response info: %+v", info)
+				}
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				test.response(w)
+			}))
+			defer s.Close()
+
+			_, err := NewClient("", WithBaseURL(s.URL)).RetrieveTracker("trk_1")
+			test.check(t, err)
+		})
+	}
+}
+
+func TestTransportError(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	s.Close()
+
+	_, err := NewClient("", WithBaseURL(s.URL)).RetrieveTracker("trk_1")
+	var transportError TransportError
+	if !errors.As(err, &transportError) || transportError.Unwrap() == nil {
+		t.Fatalf("expected TransportError, got: %T(%v)", err, err)
+	}
+}
+
+func TestFieldErrors(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		details  string
+		expected []FieldError
+	}{
+		{
+			name:    "objects",
+			details: `[{"field": "tracking_code", "message": "not found"}]`,
+			expected: []FieldError{
+				{Field: "tracking_code", Message: "not found"},
+			},
+		},
+		{
+			name:    "strings",
+			details: `["weight is required", "length is invalid"]`,
+			expected: []FieldError{
+				{Message: "weight is required"},
+				{Message: "length is invalid"},
+			},
+		},
+		{
+			name:    "mixed",
+			details: `["weight is required", {"field": "street1", "message": "House number is missing", "code": "E.HOUSE_NUMBER.MISSING"}, 1]`,
+			expected: []FieldError{
+				{Message: "weight is required"},
+				{Field: "street1", Message: "House number is missing", Code: AddressVerificationHouseNumberMissing},
+			},
+		},
+		{
+			name: "missing",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			err := ProcessingError{details: json.RawMessage(test.details)}
+			if fieldErrors := err.FieldErrors(); !reflect.DeepEqual(fieldErrors, test.expected) {
+				t.Fatalf("field errors: \nexpected %+v\n     got %+v", test.expected, fieldErrors)
+			}
+		})
+	}
+}
+
+func TestErrorCodeOf(t *testing.T) {
+	for _, test := range []struct {
+		err      error
+		expected ErrorCode
+	}{
+		{err: ProcessingError{code: string(AddressNotFound)}, expected: AddressNotFound},
+		{err: fmt.Errorf("wrapped: %w", RateLimitError{Code: "RATE_LIMITED"}), expected: "RATE_LIMITED"},
+		{err: ServerError{Code: AddressVerifyUnavailable}, expected: AddressVerifyUnavailable},
+		{err: NotFoundError{}, expected: ""},
+		{err: nil, expected: ""},
+	} {
+		if code := ErrorCodeOf(test.err); code != test.expected {
+			t.Fatalf("%v: unexpected code, expected: %q, got: %q", test.err, test.expected, code)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypost
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"net/url"
+	"slices"
+)
+
+type EventListParams struct {
+	ListOptions
+}
+
+type EventList struct {
+	Events  []Event `json:"events"`
+	HasMore bool    `json:"has_more"`
+}
+
+// Payload is a delivery of an event to a webhook, RequestBody is the event
+// as it was sent.
+type Payload struct {
+	ID              string            `json:"id"`
+	Object          RecordType        `json:"object"`
+	RequestURL      string            `json:"request_url"`
+	RequestHeaders  map[string]string `json:"request_headers"`
+	RequestBody     string            `json:"request_body"`
+	ResponseHeaders map[string]string `json:"response_headers"`
+	ResponseCode    int               `json:"response_code"`
+	ResponseBody    string            `json:"response_body"`
+	// TotalTime is the duration of the delivery in milliseconds.
+	TotalTime int      `json:"total_time"`
+	CreatedAt DateTime `json:"created_at"`
+	UpdatedAt DateTime `json:"updated_at"`
+}
+
+// Event decodes the delivered event.
+func (p Payload) Event() (*Event, error) {
+	event := Event{}
+	if err := json.Unmarshal([]byte(p.RequestBody), &event); err != nil {
+		return nil, fmt.Errorf("error decode payload event: %s", err)
+	}
+	return &event, nil
+}
+
+// ListEvents returns a page of events, e.g. to find the ones which weren't
+// delivered while the webhook endpoint was down.
+func (c *Client) ListEvents(params EventListParams) (*EventList, error) {
+	return c.ListEventsContext(context.Background(), params)
+}
+
+func (c *Client) ListEventsContext(ctx context.Context, params EventListParams) (*EventList, error) {
+	parameters := url.Values{}
+	params.ListOptions.encode(parameters)
+
+	responseBody, err := c.get(ctx, eventURL, parameters)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[EventList](responseBody)
+}
+
+// AllEvents returns an iterator over events matching params, fetching
+// further pages as needed. The iteration stops at the first error.
+func (c *Client) AllEvents(ctx context.Context, params EventListParams) iter.Seq2[*Event, error] {
+	return listAll[Event](ctx, c, eventURL, "events", params.ListOptions, url.Values{})
+}
+
+func (c *Client) GetEvent(id string) (*Event, error) {
+	return c.GetEventContext(context.Background(), id)
+}
+
+func (c *Client) GetEventContext(ctx context.Context, id string) (*Event, error) {
+	responseBody, err := c.get(ctx, objectPath(eventURL, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[Event](responseBody)
+}
+
+// ListEventPayloads returns deliveries of the event to all webhooks.
+func (c *Client) ListEventPayloads(eventID string) ([]Payload, error) {
+	return c.ListEventPayloadsContext(context.Background(), eventID)
+}
+
+func (c *Client) ListEventPayloadsContext(ctx context.Context, eventID string) ([]Payload, error) {
+	responseBody, err := c.get(ctx, objectPath(eventURL, eventID, "payloads"), nil)
+	if err != nil {
+		return nil, err
+	}
+	list, err := decodeResponse[struct {
+		Payloads []Payload `json:"payloads"`
+	}](responseBody)
+	if err != nil {
+		return nil, err
+	}
+	return list.Payloads, nil
+}
+
+func (c *Client) GetEventPayload(eventID, payloadID string) (*Payload, error) {
+	return c.GetEventPayloadContext(context.Background(), eventID, payloadID)
+}
+
+func (c *Client) GetEventPayloadContext(ctx context.Context, eventID, payloadID string) (*Payload, error) {
+	responseBody, err := c.get(ctx, objectPath(eventURL, eventID, "payloads", url.PathEscape(payloadID)), nil)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[Payload](responseBody)
+}
+
+// Replay dispatches past events, e.g. from Client.AllEvents, the same way
+// as the mux dispatches delivered ones, including de-duplication. Events are
+// dispatched from the oldest to the newest one, so all of them are read
+// before the first is dispatched. A failure of an event doesn't stop the
+// replay, the returned error joins errors of all failed events.
+func (m *WebHookMux) Replay(ctx context.Context, events iter.Seq2[*Event, error]) error {
+	var pending []*Event
+	for event, err := range events {
+		if err != nil {
+			return fmt.Errorf("error read events: %w", err)
+		}
+		pending = append(pending, event)
+	}
+	slices.SortStableFunc(pending, func(a, b *Event) int {
+		return cmp.Compare(a.CreatedAt.UnixNano(), b.CreatedAt.UnixNano())
+	})
+
+	var errs []error
+	for _, event := range pending {
+		if err := ctx.Err(); err != nil {
+			return errors.Join(append(errs, err)...)
+		}
+		if err := m.Dispatch(ctx, event); err != nil {
+			errs = append(errs, fmt.Errorf("error replay event %s: %w", event.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypost
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestListEvents(t *testing.T) {
+	setup()
+
+	list, err := testClient.ListEvents(EventListParams{ListOptions{
+		PageSize:      2,
+		StartDateTime: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Events) != 2 || list.Events[0].ID != "evt_7" || list.Events[1].ID != "evt_6" || !list.HasMore {
+		t.Fatalf("unexpected events: %+v", list)
+	}
+
+	var ids []string
+	for event, err := range testClient.AllEvents(context.Background(), EventListParams{ListOptions{
+		PageSize:    2,
+		EndDateTime: time.Date(2026, 1, 4, 0, 0, 0, 0, time.UTC),
+	}}) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, event.ID)
+	}
+	if expected := []string{"evt_3", "evt_2", "evt_1"}; !slices.Equal(ids, expected) {
+		t.Fatalf("unexpected events, expected: %v, got: %v", expected, ids)
+	}
+}
+
+func TestGetEvent(t *testing.T) {
+	setup()
+
+	event, err := testClient.GetEvent("evt_3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := event.GetResult()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tracker, ok := result.(*Tracker); !ok || tracker.ID != "trk_3" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+
+	var notFoundError NotFoundError
+	if _, err := testClient.GetEvent("evt_8"); !errors.As(err, &notFoundError) {
+		t.Fatalf("not found error expected, got: %v", err)
+	}
+}
+
+func TestEventPayloads(t *testing.T) {
+	setup()
+
+	payloads, err := testClient.ListEventPayloads("evt_3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(payloads) != 1 || payloads[0].ResponseCode != http.StatusServiceUnavailable {
+		t.Fatalf("unexpected payloads: %+v", payloads)
+	}
+
+	payload, err := testClient.GetEventPayload("evt_3", payloads[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	event, err := payload.Event()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.ID != "evt_3" || event.Description != EventTrackerUpdated {
+		t.Fatalf("unexpected payload event: %+v", event)
+	}
+}
+
+func TestReplay(t *testing.T) {
+	setup()
+
+	var replayed []string
+	m := NewWebHookMux(NewWebHookHandler("", ""))
+	m.OnTrackerUpdated(func(ctx context.Context, e *Event, tracker *Tracker) error {
+		replayed = append(replayed, tracker.ID)
+		if tracker.ID == "trk_2" {
+			return errors.New("failing")
+		}
+		return nil
+	})
+	m.Deduplicate(NewMemoryEventStore(time.Hour))
+
+	events := testClient.AllEvents(context.Background(), EventListParams{ListOptions{PageSize: 3}})
+	if err := m.Replay(context.Background(), events); err == nil {
+		t.Fatal("error of the failed event expected")
+	}
+	if expected := []string{"trk_1", "trk_2", "trk_3", "trk_4", "trk_5", "trk_6", "trk_7"}; !slices.Equal(replayed, expected) {
+		t.Fatalf("unexpected replayed trackers, expected: %v, got: %v", expected, replayed)
+	}
+
+	// Only the failed event is replayed again.
+	replayed = nil
+	if err := m.Replay(context.Background(), events); err == nil {
+		t.Fatalf("error of the failed event expected, replayed: %v", replayed)
+	}
+	if expected := []string{"trk_2"}; !slices.Equal(replayed, expected) {
+		t.Fatalf("unexpected replayed trackers, expected: %v, got: %v", expected, replayed)
+	}
+
+	listErr := errors.New("list failed")
+	err := m.Replay(context.Background(), func(yield func(*Event, error) bool) {
+		yield(nil, listErr)
+	})
+	if !errors.Is(err, listErr) {
+		t.Fatalf("list error expected, got: %v", err)
+	}
+}
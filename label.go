@@ -0,0 +1,124 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypost
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"slices"
+)
+
+// defaultMaxLabelSize limits the size of downloaded labels unless
+// WithMaxLabelSize is used.
+const defaultMaxLabelSize = 10 << 20
+
+type LabelFormat string
+
+const (
+	LabelFormatPNG  LabelFormat = "PNG"
+	LabelFormatPDF  LabelFormat = "PDF"
+	LabelFormatZPL  LabelFormat = "ZPL"
+	LabelFormatEPL2 LabelFormat = "EPL2"
+)
+
+// labelContentTypes are media types S3 serves labels of each format with.
+var labelContentTypes = map[LabelFormat][]string{
+	LabelFormatPNG:  {"image/png"},
+	LabelFormatPDF:  {"application/pdf"},
+	LabelFormatZPL:  {"application/zpl", "text/plain", "application/octet-stream"},
+	LabelFormatEPL2: {"application/epl2", "text/plain", "application/octet-stream"},
+}
+
+// WithMaxLabelSize limits the size of labels downloaded by DownloadLabel.
+func WithMaxLabelSize(n int64) Option {
+	return func(o *clientOptions) {
+		o.maxLabelSize = n
+	}
+}
+
+// URL returns the URL of the label in the format. Labels other than PNG are
+// available only after they are converted with ConvertLabel.
+func (l PostageLabel) URL(format LabelFormat) (string, bool) {
+	var u string
+	switch format {
+	case LabelFormatPNG:
+		if l.LabelFileType == "image/png" {
+			u = l.LabelURL
+		}
+	case LabelFormatPDF:
+		u = l.LabelPDFURL
+	case LabelFormatZPL:
+		u = l.LabelZPLURL
+	case LabelFormatEPL2:
+		u = l.LabelEPL2URL
+	}
+	return u, u != ""
+}
+
+// ConvertLabel generates the label of the bought shipment in the format.
+// The returned shipment has the URL of the label set in its PostageLabel.
+func (c *Client) ConvertLabel(shipmentID string, format LabelFormat) (*Shipment, error) {
+	return c.ConvertLabelContext(context.Background(), shipmentID, format)
+}
+
+func (c *Client) ConvertLabelContext(ctx context.Context, shipmentID string, format LabelFormat) (*Shipment, error) {
+	parameters := url.Values{}
+	parameters.Set("file_format", string(format))
+
+	responseBody, err := c.get(ctx, objectPath(shipmentURL, shipmentID, "label"), parameters)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[Shipment](responseBody)
+}
+
+// DownloadLabel returns the content of the label in the format. The label is
+// downloaded through the http.Client of c, but without EasyPost credentials.
+func (c *Client) DownloadLabel(ctx context.Context, label PostageLabel, format LabelFormat) ([]byte, error) {
+	labelURL, ok := label.URL(format)
+	if !ok {
+		return nil, LabelNotAvailableError{format: format}
+	}
+
+	r, err := http.NewRequestWithContext(ctx, http.MethodGet, labelURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error building label request: %s", err)
+	}
+	r.Header.Set("User-Agent", c.userAgent)
+
+	response, err := c.c.Do(r)
+	if err != nil {
+		return nil, fmt.Errorf("error downloading label: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error downloading label: unexpected status %s", response.Status)
+	}
+	contentType, _, err := mime.ParseMediaType(response.Header.Get("Content-Type"))
+	if err != nil || !slices.Contains(labelContentTypes[format], contentType) {
+		return nil, fmt.Errorf("error downloading label: unexpected content type %q for %s", response.Header.Get("Content-Type"), format)
+	}
+	if response.ContentLength > c.maxLabelSize {
+		return nil, fmt.Errorf("error downloading label: size %d exceeds %d bytes", response.ContentLength, c.maxLabelSize)
+	}
+
+	b, err := io.ReadAll(io.LimitReader(response.Body, c.maxLabelSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("error downloading label: %w", err)
+	}
+	if int64(len(b)) > c.maxLabelSize {
+		return nil, fmt.Errorf("error downloading label: size exceeds %d bytes", c.maxLabelSize)
+	}
+	return b, nil
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypost
+
+import (
+	"context"
+	"testing"
+)
+
+func TestPostageLabelURL(t *testing.T) {
+	shipment := readTestShipment(t, "shp_1_bought")
+	if u, ok := shipment.PostageLabel.URL(LabelFormatPNG); !ok || u != shipment.PostageLabel.LabelURL {
+		t.Errorf("unexpected PNG label url: %s", u)
+	}
+	if u, ok := shipment.PostageLabel.URL(LabelFormatZPL); ok {
+		t.Errorf("unexpected ZPL label url: %s", u)
+	}
+}
+
+func TestConvertAndDownloadLabel(t *testing.T) {
+	setup()
+
+	shipment, err := testClient.ConvertLabel("shp_1", LabelFormatZPL)
+	if err != nil {
+		t.Fatalf("not success response: %s", err)
+	}
+	if _, ok := shipment.PostageLabel.URL(LabelFormatZPL); !ok {
+		t.Fatalf("missing ZPL label url: %+v", shipment.PostageLabel)
+	}
+
+	label, err := testClient.DownloadLabel(context.Background(), *shipment.PostageLabel, LabelFormatZPL)
+	if err != nil {
+		t.Fatalf("error downloading label: %s", err)
+	}
+	if string(label) != "^XA^FO50,50^A0N,50,50^FDEasyPost^FS^XZ" {
+		t.Fatalf("unexpected label: %s", label)
+	}
+
+	_, err = testClient.DownloadLabel(context.Background(), *shipment.PostageLabel, LabelFormatEPL2)
+	if _, ok := err.(LabelNotAvailableError); !ok {
+		t.Fatalf("expected LabelNotAvailableError, got: %T(%v)", err, err)
+	}
+
+	c := NewClient("", WithBaseURL(testServer.URL), WithMaxLabelSize(10))
+	if _, err := c.DownloadLabel(context.Background(), *shipment.PostageLabel, LabelFormatZPL); err == nil {
+		t.Fatal("size limit error expected")
+	}
+
+	shipment, err = testClient.ConvertLabel("shp_1", LabelFormatPDF)
+	if err != nil {
+		t.Fatalf("not success response: %s", err)
+	}
+	if _, err := testClient.DownloadLabel(context.Background(), *shipment.PostageLabel, LabelFormatPDF); err == nil {
+		t.Fatal("content type error expected")
+	}
+}
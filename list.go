@@ -0,0 +1,119 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypost
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// ListOptions are common parameters of list calls. Records are listed from
+// the newest to the oldest one.
+type ListOptions struct {
+	// PageSize is the maximum number of records in a page, EasyPost uses 20
+	// by default and allows at most 100.
+	PageSize int
+	// BeforeID limits records to the ones created before the record with ID.
+	BeforeID string
+	// AfterID limits records to the ones created after the record with ID.
+	AfterID string
+	// StartDateTime limits records to the ones created at or after it.
+	StartDateTime time.Time
+	// EndDateTime limits records to the ones created before it.
+	EndDateTime time.Time
+}
+
+func (o ListOptions) encode(parameters url.Values) {
+	if o.PageSize > 0 {
+		parameters.Set("page_size", strconv.Itoa(o.PageSize))
+	}
+	if o.BeforeID != "" {
+		parameters.Set("before_id", o.BeforeID)
+	}
+	if o.AfterID != "" {
+		parameters.Set("after_id", o.AfterID)
+	}
+	if !o.StartDateTime.IsZero() {
+		parameters.Set("start_datetime", o.StartDateTime.UTC().Format(time.RFC3339))
+	}
+	if !o.EndDateTime.IsZero() {
+		parameters.Set("end_datetime", o.EndDateTime.UTC().Format(time.RFC3339))
+	}
+}
+
+// listAll returns an iterator over records of all pages of the list at
+// objectURL. Pages are fetched lazily, each next one starts before the last
+// record of the previous page. The iteration stops at the first error, which
+// is yielded with nil record. recordsKey is the field of the page which holds
+// records.
+func listAll[T any](ctx context.Context, c *Client, objectURL, recordsKey string, options ListOptions, parameters url.Values) iter.Seq2[*T, error] {
+	return func(yield func(*T, error) bool) {
+		// Every iteration starts from the first page.
+		options := options
+		for {
+			pageParameters := url.Values{}
+			for k, v := range parameters {
+				pageParameters[k] = v
+			}
+			options.encode(pageParameters)
+
+			records, hasMore, err := listPage(ctx, c, objectURL, recordsKey, pageParameters)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, raw := range records {
+				record := Record{}
+				if err := json.Unmarshal(raw, &record); err != nil {
+					yield(nil, fmt.Errorf("error decode response: %s", err))
+					return
+				}
+				v, err := decodeResponse[T](raw)
+				if err != nil {
+					yield(nil, err)
+					return
+				}
+				if !yield(v, nil) {
+					return
+				}
+				options.BeforeID = record.ID
+			}
+			if !hasMore || len(records) == 0 {
+				return
+			}
+		}
+	}
+}
+
+func listPage(ctx context.Context, c *Client, objectURL, recordsKey string, parameters url.Values) ([]json.RawMessage, bool, error) {
+	responseBody, err := c.get(ctx, objectURL, parameters)
+	if err != nil {
+		return nil, false, err
+	}
+	page := map[string]json.RawMessage{}
+	if err := json.Unmarshal(responseBody, &page); err != nil {
+		return nil, false, fmt.Errorf("error decode response: %s", err)
+	}
+	var records []json.RawMessage
+	if err := json.Unmarshal(page[recordsKey], &records); err != nil {
+		return nil, false, fmt.Errorf("error decode response %s: %s", recordsKey, err)
+	}
+	var hasMore bool
+	if raw, ok := page["has_more"]; ok {
+		if err := json.Unmarshal(raw, &hasMore); err != nil {
+			return nil, false, fmt.Errorf("error decode response has_more: %s", err)
+		}
+	}
+	return records, hasMore, nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypost
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAllTrackers(t *testing.T) {
+	setup()
+
+	for _, test := range []struct {
+		name        string
+		params      TrackerListParams
+		expectedIDs []string
+	}{
+		{
+			name:        "all",
+			params:      TrackerListParams{ListOptions: ListOptions{PageSize: 2}},
+			expectedIDs: []string{"trk_7", "trk_6", "trk_5", "trk_4", "trk_3", "trk_2", "trk_1"},
+		},
+		{
+			name: "time bounds",
+			params: TrackerListParams{ListOptions: ListOptions{
+				PageSize:      1,
+				StartDateTime: time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC),
+				EndDateTime:   time.Date(2026, 1, 6, 0, 0, 0, 0, time.UTC),
+			}},
+			expectedIDs: []string{"trk_5", "trk_4", "trk_3"},
+		},
+		{
+			name:        "filter",
+			params:      TrackerListParams{ListOptions: ListOptions{PageSize: 2, BeforeID: "trk_6"}, Carrier: "USPS"},
+			expectedIDs: []string{"trk_5", "trk_3", "trk_1"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var ids []string
+			for tracker, err := range testClient.AllTrackers(context.Background(), test.params) {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				ids = append(ids, tracker.ID)
+			}
+			if !reflect.DeepEqual(ids, test.expectedIDs) {
+				t.Fatalf("trackers: \nexpected %v\n     got %v", test.expectedIDs, ids)
+			}
+		})
+	}
+}
+
+func TestAllTrackersLazy(t *testing.T) {
+	setup()
+
+	requests := 0
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		testServer.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer s.Close()
+	c := NewClient("", WithBaseURL(s.URL))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var ids []string
+	var iterationError error
+	for tracker, err := range c.AllTrackers(ctx, TrackerListParams{ListOptions: ListOptions{PageSize: 2}}) {
+		if err != nil {
+			iterationError = err
+			break
+		}
+		ids = append(ids, tracker.ID)
+		if len(ids) == 3 {
+			cancel()
+		}
+	}
+	if !errors.Is(iterationError, context.Canceled) {
+		t.Fatalf("context canceled error expected, got: %v", iterationError)
+	}
+	if expectedIDs := []string{"trk_7", "trk_6", "trk_5", "trk_4"}; !reflect.DeepEqual(ids, expectedIDs) {
+		t.Fatalf("trackers: \nexpected %v\n     got %v", expectedIDs, ids)
+	}
+
+	requests = 0
+	for range c.AllTrackers(context.Background(), TrackerListParams{ListOptions: ListOptions{PageSize: 2}}) {
+		break
+	}
+	if requests != 1 {
+		t.Fatalf("unexpected number of requests, expected: 1, got: %d", requests)
+	}
+}
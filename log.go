@@ -0,0 +1,108 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypost
+
+import (
+	"log/slog"
+	"net/http"
+)
+
+const redacted = "[REDACTED]"
+
+// WithLogger makes the Client log every attempt of a request to l, it is a
+// shortcut for WithMiddleware(LoggingMiddleware(l)).
+func WithLogger(l *slog.Logger) Option {
+	return WithMiddleware(LoggingMiddleware(l))
+}
+
+// LoggingMiddleware logs every attempt of a request to l: successful attempts
+// at debug level and failed ones at error level. Records have method, path,
+// attempt, status, latency, request_id and code attributes. Request
+// parameters and the API key are never logged.
+func LoggingMiddleware(l *slog.Logger) Middleware {
+	return func(next Handler) Handler {
+		return func(r *http.Request) (*Response, error) {
+			response, err := next(r)
+			logAttempt(l, r, response, err)
+			return response, err
+		}
+	}
+}
+
+func logAttempt(l *slog.Logger, r *http.Request, response *Response, err error) {
+	ctx := r.Context()
+	level := slog.LevelDebug
+	if err != nil {
+		level = slog.LevelError
+	}
+	if !l.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", r.Method),
+		slog.String("path", requestPath(r)),
+		slog.Int("attempt", RequestAttempt(r)),
+	}
+	if response != nil {
+		attrs = append(attrs,
+			slog.Int("status", response.StatusCode),
+			slog.Duration("latency", response.Latency),
+			slog.String("request_id", response.RequestID),
+		)
+	}
+	if err == nil {
+		l.LogAttrs(ctx, level, "easypost request", attrs...)
+		return
+	}
+	if code := ErrorCodeOf(err); code != "" {
+		attrs = append(attrs, slog.String("code", string(code)))
+	}
+	attrs = append(attrs, slog.String("error", err.Error()))
+	l.LogAttrs(ctx, level, "easypost request failed", attrs...)
+}
+
+// LogValue implements slog.LogValuer, it redacts the API key.
+func (c *Client) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("base_url", c.baseURL),
+		slog.String("user_agent", c.userAgent),
+		slog.String("api_key", redacted),
+	)
+}
+
+// LogValue implements slog.LogValuer, it redacts name, phone and email.
+func (a Address) LogValue() slog.Value {
+	attrs := []slog.Attr{
+		slog.String("id", a.ID),
+		slog.String("street1", a.Street1),
+		slog.String("street2", a.Street2),
+		slog.String("city", a.City),
+		slog.String("state", a.State),
+		slog.String("zip", a.Zip),
+		slog.String("country", a.Country),
+		slog.Bool("residential", a.Residential),
+	}
+	if a.Company != nil {
+		attrs = append(attrs, slog.String("company", *a.Company))
+	}
+	for _, field := range []struct {
+		key   string
+		value *string
+	}{
+		{"name", a.Name},
+		{"phone", a.Phone},
+		{"email", a.Email},
+	} {
+		if field.value != nil {
+			attrs = append(attrs, slog.String(field.key, redacted))
+		}
+	}
+	return slog.GroupValue(attrs...)
+}
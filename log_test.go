@@ -0,0 +1,133 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypost
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestLogger(buf *bytes.Buffer) *slog.Logger {
+	return slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+}
+
+func TestLogger(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(requestIDHeader, "req-1")
+		if r.FormValue("tracker[tracking_code]") == "INVALID" {
+			writeTestProcessingError(w, "TRACKER.INVALID_PARAMS", "invalid tracking code")
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id": "trk_1", "object": "Tracker"}`))
+	}))
+	defer s.Close()
+
+	var buf bytes.Buffer
+	c := NewClient("secret-key", WithBaseURL(s.URL), WithLogger(newTestLogger(&buf)))
+	if _, err := c.CreateTracker("EZ3000000003", ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.CreateTracker("INVALID", ""); err == nil {
+		t.Fatal("error expected")
+	}
+
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var record map[string]any
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatal(err)
+		}
+		records = append(records, record)
+	}
+	if len(records) != 2 {
+		t.Fatalf("unexpected number of records, expected: 2, got: %d\n%s", len(records), buf.String())
+	}
+	for i, expected := range []map[string]any{
+		{"level": "DEBUG", "method": "POST", "path": "trackers", "status": float64(201), "request_id": "req-1"},
+		{"level": "ERROR", "method": "POST", "path": "trackers", "status": float64(422), "request_id": "req-1", "code": "TRACKER.INVALID_PARAMS"},
+	} {
+		for key, value := range expected {
+			if records[i][key] != value {
+				t.Fatalf("record %d: unexpected %s, expected: %v, got: %v", i, key, value, records[i][key])
+			}
+		}
+		if _, ok := records[i]["latency"]; !ok {
+			t.Fatalf("record %d: latency expected", i)
+		}
+	}
+}
+
+func TestLoggerRedaction(t *testing.T) {
+	s := httptest.NewServer(http.NotFoundHandler())
+	s.Close()
+
+	var buf bytes.Buffer
+	l := newTestLogger(&buf)
+	printf := &testPrintfLogger{}
+	c := NewClient("secret-key", WithBaseURL(s.URL), WithLogger(l),
+		WithRetryPolicy(RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond}))
+	c.SetErrorLog(printf)
+	name, phone, email := "Jane Doe", "5555555555", "jane@example.com"
+	address := Address{Street1: "1 Main St", Name: &name, Phone: &phone, Email: &email}
+	_, addressErr := c.VerifyAndCreateAddress(address, "")
+	if addressErr == nil {
+		t.Fatal("error expected")
+	}
+	_, trackerErr := c.ListTrackers(TrackerListParams{TrackingCode: "EZ3000000003"})
+	if trackerErr == nil {
+		t.Fatal("error expected")
+	}
+	if len(printf.lines) == 0 {
+		t.Fatal("retry is not logged")
+	}
+	l.Info("address", "address", address, "client", c)
+
+	for source, out := range map[string]string{
+		"slog":          buf.String(),
+		"printf":        strings.Join(printf.lines, ""),
+		"address error": addressErr.Error(),
+		"tracker error": trackerErr.Error(),
+	} {
+		for _, secret := range []string{"secret-key", name, phone, email, url.QueryEscape(name), url.QueryEscape(email), "EZ3000000003", "?"} {
+			if strings.Contains(out, secret) {
+				t.Fatalf("%s: %q is not redacted:\n%s", source, secret, out)
+			}
+		}
+	}
+	if !strings.Contains(buf.String(), "1 Main St") {
+		t.Fatalf("address is not logged:\n%s", buf.String())
+	}
+}
+
+type testPrintfLogger struct {
+	lines []string
+}
+
+func (l *testPrintfLogger) Printf(format string, v ...interface{}) {
+	l.lines = append(l.lines, fmt.Sprintf(format, v...))
+}
+
+func TestErrorLog(t *testing.T) {
+	l := &testPrintfLogger{}
+	c := NewClient("")
+	c.SetErrorLog(l)
+	c.errorf("%s %d\n", "status", 500)
+	if len(l.lines) != 1 || l.lines[0] != "status 500\n" {
+		t.Fatalf("unexpected log lines: %q", l.lines)
+	}
+}
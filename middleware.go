@@ -0,0 +1,108 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypost
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// Response is the response to a single attempt of an API request, with the
+// body already read.
+type Response struct {
+	ResponseInfo
+	Header http.Header
+	Body   []byte
+}
+
+// Handler sends an API request. The returned response is nil if the request
+// didn't reach EasyPost, otherwise it is returned along with the error of
+// the response, e.g. a ProcessingError. A Handler must return a response or
+// an error, the Client fails the request with ErrNoResponse otherwise.
+type Handler func(r *http.Request) (*Response, error)
+
+// ErrNoResponse is returned when a Handler or an OperationHandler returns
+// neither a response nor an error.
+var ErrNoResponse = errors.New("handler returned neither response nor error")
+
+// Middleware wraps a Handler to observe or modify requests and responses,
+// e.g. for tracing, metrics or request signing. Middlewares run for every
+// attempt of a request, including retries.
+type Middleware func(next Handler) Handler
+
+// WithMiddleware adds middlewares to the Client. The first middleware is the
+// outermost one, it sees the request first and the response last.
+func WithMiddleware(middlewares ...Middleware) Option {
+	return func(o *clientOptions) {
+		o.middlewares = append(o.middlewares, middlewares...)
+	}
+}
+
+// Operation describes an API call, which is made with one or more attempts.
+type Operation struct {
+	Method string
+	// Path is relative to the base URL of the Client, e.g. "trackers/trk_1".
+	Path string
+}
+
+// OperationHandler makes all attempts of an API call, including waits for
+// the rate limiter and backoff between retries. It returns the response to
+// the last attempt, which is nil if that attempt didn't reach EasyPost.
+type OperationHandler func(ctx context.Context, op Operation) (*Response, error)
+
+// OperationMiddleware wraps an OperationHandler to observe API calls as a
+// whole, e.g. for tracing or measuring them including retries. It runs once
+// per call, attempts are made with the context it passes to next.
+type OperationMiddleware func(next OperationHandler) OperationHandler
+
+// WithOperationMiddleware adds operation middlewares to the Client. The
+// first middleware is the outermost one.
+func WithOperationMiddleware(middlewares ...OperationMiddleware) Option {
+	return func(o *clientOptions) {
+		o.operationMiddlewares = append(o.operationMiddlewares, middlewares...)
+	}
+}
+
+type attemptKey struct{}
+
+type attemptInfo struct {
+	path    string
+	attempt int
+}
+
+// RequestAttempt returns the number of the attempt of r, starting from 1, or
+// 0 if r wasn't made by a Client.
+func RequestAttempt(r *http.Request) int {
+	info, _ := r.Context().Value(attemptKey{}).(attemptInfo)
+	return info.attempt
+}
+
+// requestPath returns the path of r relative to the base URL of the Client.
+func requestPath(r *http.Request) string {
+	if info, ok := r.Context().Value(attemptKey{}).(attemptInfo); ok {
+		return info.path
+	}
+	return r.URL.Path
+}
+
+// TimingMiddleware reports the duration of every attempt to observe,
+// including the time spent in the middlewares it wraps. response is nil if
+// the request didn't reach EasyPost.
+func TimingMiddleware(observe func(r *http.Request, response *Response, d time.Duration, err error)) Middleware {
+	return func(next Handler) Handler {
+		return func(r *http.Request) (*Response, error) {
+			start := time.Now()
+			response, err := next(r)
+			observe(r, response, time.Since(start), err)
+			return response, err
+		}
+	}
+}
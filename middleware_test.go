@@ -0,0 +1,161 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypost
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestMiddleware(t *testing.T) {
+	var signatures []string
+	s, requests := newFailingServer(t, 1, func(w http.ResponseWriter) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	s.Config.Handler = func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			signatures = append(signatures, r.Header.Get("X-Signature"))
+			h.ServeHTTP(w, r)
+		})
+	}(s.Config.Handler)
+
+	var calls []string
+	record := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return func(r *http.Request) (*Response, error) {
+				calls = append(calls, name+" request")
+				response, err := next(r)
+				calls = append(calls, name+" response")
+				return response, err
+			}
+		}
+	}
+	sign := func(next Handler) Handler {
+		return func(r *http.Request) (*Response, error) {
+			r.Header.Set("X-Signature", "signed")
+			return next(r)
+		}
+	}
+	var attempts []int
+	var statuses []int
+	timing := TimingMiddleware(func(r *http.Request, response *Response, d time.Duration, err error) {
+		attempts = append(attempts, RequestAttempt(r))
+		statuses = append(statuses, response.StatusCode)
+		if d <= 0 {
+			t.Errorf("attempt %d: duration expected", RequestAttempt(r))
+		}
+		if (response.StatusCode == http.StatusServiceUnavailable) != (err != nil) {
+			t.Errorf("attempt %d: unexpected error: %v", RequestAttempt(r), err)
+		}
+	})
+
+	c := NewClient("", WithBaseURL(s.URL), WithRetryPolicy(testRetryPolicy()), WithMiddleware(record("outer"), record("inner")), WithMiddleware(sign, timing))
+	if _, err := c.CreateTracker("EZ3000000003", ""); err != nil {
+		t.Fatal(err)
+	}
+
+	if *requests != 2 {
+		t.Fatalf("unexpected number of requests, expected: 2, got: %d", *requests)
+	}
+	expectedCalls := []string{"outer request", "inner request", "inner response", "outer response"}
+	expectedCalls = append(expectedCalls, expectedCalls...)
+	if !slices.Equal(calls, expectedCalls) {
+		t.Fatalf("unexpected calls:\nexpected: %q\ngot: %q", expectedCalls, calls)
+	}
+	if !slices.Equal(signatures, []string{"signed", "signed"}) {
+		t.Fatalf("unexpected signatures: %q", signatures)
+	}
+	if !slices.Equal(attempts, []int{1, 2}) {
+		t.Fatalf("unexpected attempts: %v", attempts)
+	}
+	if !slices.Equal(statuses, []int{http.StatusServiceUnavailable, http.StatusCreated}) {
+		t.Fatalf("unexpected statuses: %v", statuses)
+	}
+}
+
+func TestMiddlewareShortCircuit(t *testing.T) {
+	errDenied := errors.New("denied")
+	c := NewClient("", WithBaseURL("http://127.0.0.1:0"), WithMiddleware(func(next Handler) Handler {
+		return func(r *http.Request) (*Response, error) {
+			return nil, errDenied
+		}
+	}))
+	if _, err := c.CreateTracker("EZ3000000003", ""); !errors.Is(err, errDenied) {
+		t.Fatalf("middleware error expected, got: %v", err)
+	}
+}
+
+func TestMiddlewareNoResponse(t *testing.T) {
+	c := NewClient("", WithBaseURL("http://127.0.0.1:0"), WithMiddleware(func(next Handler) Handler {
+		return func(r *http.Request) (*Response, error) {
+			return nil, nil
+		}
+	}))
+	if _, err := c.CreateTracker("EZ3000000003", ""); !errors.Is(err, ErrNoResponse) {
+		t.Fatalf("no response error expected, got: %v", err)
+	}
+}
+
+func TestOperationMiddleware(t *testing.T) {
+	s, requests := newFailingServer(t, 1, func(w http.ResponseWriter) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	type operationKey struct{}
+	var operations []Operation
+	var statuses []int
+	operation := func(next OperationHandler) OperationHandler {
+		return func(ctx context.Context, op Operation) (*Response, error) {
+			operations = append(operations, op)
+			response, err := next(context.WithValue(ctx, operationKey{}, op.Path), op)
+			statuses = append(statuses, response.StatusCode)
+			return response, err
+		}
+	}
+	var attemptPaths []any
+	attempt := func(next Handler) Handler {
+		return func(r *http.Request) (*Response, error) {
+			attemptPaths = append(attemptPaths, r.Context().Value(operationKey{}))
+			return next(r)
+		}
+	}
+
+	c := NewClient("", WithBaseURL(s.URL), WithRetryPolicy(testRetryPolicy()), WithOperationMiddleware(operation), WithMiddleware(attempt))
+	if _, err := c.CreateTracker("EZ3000000003", ""); err != nil {
+		t.Fatal(err)
+	}
+
+	if *requests != 2 {
+		t.Fatalf("unexpected number of requests, expected: 2, got: %d", *requests)
+	}
+	if !slices.Equal(operations, []Operation{{Method: http.MethodPost, Path: "trackers"}}) {
+		t.Fatalf("unexpected operations: %v", operations)
+	}
+	if !slices.Equal(statuses, []int{http.StatusCreated}) {
+		t.Fatalf("unexpected statuses: %v", statuses)
+	}
+	if !slices.Equal(attemptPaths, []any{"trackers", "trackers"}) {
+		t.Fatalf("operation context isn't passed to attempts: %v", attemptPaths)
+	}
+}
+
+func TestOperationMiddlewareNoResponse(t *testing.T) {
+	c := NewClient("", WithBaseURL("http://127.0.0.1:0"), WithOperationMiddleware(func(next OperationHandler) OperationHandler {
+		return func(ctx context.Context, op Operation) (*Response, error) {
+			return nil, nil
+		}
+	}))
+	if _, err := c.CreateTracker("EZ3000000003", ""); !errors.Is(err, ErrNoResponse) {
+		t.Fatalf("no response error expected, got: %v", err)
+	}
+}
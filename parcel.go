@@ -0,0 +1,178 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypost
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"strconv"
+)
+
+// predefinedPackages are carrier specific package names which can be used
+// as Parcel.PredefinedPackage instead of dimensions.
+var predefinedPackages = map[Carrier][]string{
+	CarrierUSPS: {
+		"Card", "Letter", "Flat", "FlatRateEnvelope", "FlatRateLegalEnvelope", "FlatRatePaddedEnvelope",
+		"FlatRateGiftCardEnvelope", "FlatRateWindowEnvelope", "FlatRateCardboardEnvelope", "SmallFlatRateEnvelope",
+		"Parcel", "SoftPack", "SmallFlatRateBox", "MediumFlatRateBox", "LargeFlatRateBox", "LargeFlatRateBoxAPOFPO",
+		"FlatTubTrayBox", "EMMTrayBox", "FullTrayBox", "HalfTrayBox", "PMODSack",
+	},
+	CarrierUPS: {
+		"UPSLetter", "UPSExpressBox", "UPS25kgBox", "UPS10kgBox", "Tube", "Pak",
+		"SmallExpressBox", "MediumExpressBox", "LargeExpressBox",
+	},
+	CarrierFedEx: {
+		"FedExEnvelope", "FedExBox", "FedExPak", "FedExTube", "FedEx10kgBox", "FedEx25kgBox",
+		"FedExSmallBox", "FedExMediumBox", "FedExLargeBox", "FedExExtraLargeBox",
+	},
+	CarrierDHLExpress: {
+		"JumboDocument", "JumboParcel", "Document", "DHLFlyer", "Domestic", "ExpressDocument", "DHLExpressEnvelope",
+		"JumboBox", "JumboJuniorDocument", "JuniorJumboBox", "JumboJuniorParcel", "OtherDHLPackaging", "Parcel",
+		"YourPackaging",
+	},
+}
+
+// PredefinedPackagesFor returns the names of predefined packages of carrier,
+// which can be used as Parcel.PredefinedPackage instead of dimensions.
+func PredefinedPackagesFor(carrier Carrier) []string {
+	return slices.Clone(predefinedPackages[carrier])
+}
+
+// Weight is a weight in ounces, the unit used by EasyPost.
+type Weight float64
+
+func Ounces(v float64) Weight { return Weight(v) }
+func Pounds(v float64) Weight { return Weight(v * 16) }
+
+func (w Weight) Ounces() float64 { return float64(w) }
+func (w Weight) Pounds() float64 { return float64(w) / 16 }
+
+// Length is a length in inches, the unit used by EasyPost.
+type Length float64
+
+func Inches(v float64) Length      { return Length(v) }
+func Centimeters(v float64) Length { return Length(v / 2.54) }
+
+func (l Length) Inches() float64      { return float64(l) }
+func (l Length) Centimeters() float64 { return float64(l) * 2.54 }
+
+type Parcel struct {
+	ID                string     `json:"id"`
+	Object            RecordType `json:"object"`
+	Mode              string     `json:"mode"`
+	Length            Length     `json:"length"`
+	Width             Length     `json:"width"`
+	Height            Length     `json:"height"`
+	Weight            Weight     `json:"weight"`
+	PredefinedPackage string     `json:"predefined_package"`
+	CreatedAt         DateTime   `json:"created_at"`
+	UpdatedAt         DateTime   `json:"updated_at"`
+}
+
+// Validate checks that the parcel has weight, either all dimensions or none
+// of them, and a predefined package known for any carrier.
+func (p Parcel) Validate() error {
+	if p.Weight <= 0 {
+		return InvalidParcelError{Field: "weight", Message: "must be positive"}
+	}
+	dimensions := 0
+	for _, d := range []struct {
+		field string
+		value Length
+	}{
+		{"length", p.Length},
+		{"width", p.Width},
+		{"height", p.Height},
+	} {
+		if d.value < 0 {
+			return InvalidParcelError{Field: d.field, Message: "must not be negative"}
+		}
+		if d.value > 0 {
+			dimensions++
+		}
+	}
+	if dimensions != 0 && dimensions != 3 {
+		return InvalidParcelError{Field: "length", Message: "length, width and height must be set together"}
+	}
+	if p.PredefinedPackage == "" {
+		return nil
+	}
+	for _, packages := range predefinedPackages {
+		if slices.Contains(packages, p.PredefinedPackage) {
+			return nil
+		}
+	}
+	return InvalidParcelError{Field: "predefined_package", Message: "unknown package " + p.PredefinedPackage}
+}
+
+// ValidateFor validates the parcel and checks that its predefined package
+// is offered by the carrier.
+func (p Parcel) ValidateFor(carrier Carrier) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	if p.PredefinedPackage != "" && !slices.Contains(predefinedPackages[carrier], p.PredefinedPackage) {
+		return InvalidParcelError{Field: "predefined_package", Message: "package " + p.PredefinedPackage + " is not offered by " + carrier.String()}
+	}
+	return nil
+}
+
+// encode sets parcel fields as parameters nested under prefix. Parcels
+// already created in EasyPost are referred by their ID only.
+func (p Parcel) encode(prefix string, parameters url.Values) {
+	if p.ID != "" {
+		parameters.Set(prefix+"[id]", p.ID)
+		return
+	}
+	for name, value := range map[string]float64{
+		"length": p.Length.Inches(),
+		"width":  p.Width.Inches(),
+		"height": p.Height.Inches(),
+		"weight": p.Weight.Ounces(),
+	} {
+		if value != 0 {
+			parameters.Set(prefix+"["+name+"]", strconv.FormatFloat(value, 'f', -1, 64))
+		}
+	}
+	if p.PredefinedPackage != "" {
+		parameters.Set(prefix+"[predefined_package]", p.PredefinedPackage)
+	}
+}
+
+// CreateParcel validates and creates the parcel.
+func (c *Client) CreateParcel(parcel Parcel) (*Parcel, error) {
+	return c.CreateParcelContext(context.Background(), parcel)
+}
+
+func (c *Client) CreateParcelContext(ctx context.Context, parcel Parcel) (*Parcel, error) {
+	if err := parcel.Validate(); err != nil {
+		return nil, err
+	}
+	parameters := url.Values{}
+	parcel.encode("parcel", parameters)
+
+	responseBody, err := c.post(ctx, parcelURL, parameters)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[Parcel](responseBody)
+}
+
+func (c *Client) GetParcel(id string) (*Parcel, error) {
+	return c.GetParcelContext(context.Background(), id)
+}
+
+func (c *Client) GetParcelContext(ctx context.Context, id string) (*Parcel, error) {
+	responseBody, err := c.get(ctx, objectPath(parcelURL, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[Parcel](responseBody)
+}
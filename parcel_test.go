@@ -0,0 +1,144 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypost
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestUnits(t *testing.T) {
+	if w := Pounds(2.5); w.Ounces() != 40 || w.Pounds() != 2.5 {
+		t.Errorf("unexpected weight: %v", w)
+	}
+	if l := Centimeters(25.4); math.Abs(l.Inches()-10) > 1e-9 || math.Abs(l.Centimeters()-25.4) > 1e-9 {
+		t.Errorf("unexpected length: %v", l)
+	}
+}
+
+func TestParcelValidate(t *testing.T) {
+	for _, test := range []struct {
+		name          string
+		parcel        Parcel
+		carrier       Carrier
+		expectedField string
+	}{
+		{
+			name:   "dimensions",
+			parcel: Parcel{Length: Inches(10), Width: Centimeters(20), Height: Inches(3), Weight: Pounds(1)},
+		},
+		{
+			name:   "weight only",
+			parcel: Parcel{Weight: Ounces(3)},
+		},
+		{
+			name:    "predefined package",
+			parcel:  Parcel{PredefinedPackage: "FlatRateEnvelope", Weight: Ounces(3)},
+			carrier: CarrierUSPS,
+		},
+		{
+			name:          "missing weight",
+			parcel:        Parcel{Length: 1, Width: 1, Height: 1},
+			expectedField: "weight",
+		},
+		{
+			name:          "negative dimension",
+			parcel:        Parcel{Length: 1, Width: -1, Height: 1, Weight: 1},
+			expectedField: "width",
+		},
+		{
+			name:          "partial dimensions",
+			parcel:        Parcel{Length: 1, Width: 1, Weight: 1},
+			expectedField: "length",
+		},
+		{
+			name:          "unknown package",
+			parcel:        Parcel{PredefinedPackage: "Crate", Weight: 1},
+			expectedField: "predefined_package",
+		},
+		{
+			name:          "package of other carrier",
+			parcel:        Parcel{PredefinedPackage: "FedExPak", Weight: 1},
+			carrier:       CarrierUPS,
+			expectedField: "predefined_package",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.parcel.Validate()
+			if test.carrier != "" {
+				err = test.parcel.ValidateFor(test.carrier)
+			}
+			if test.expectedField == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			var parcelError InvalidParcelError
+			if !errors.As(err, &parcelError) {
+				t.Fatalf("expected InvalidParcelError, got: %T(%v)", err, err)
+			}
+			if parcelError.Field != test.expectedField {
+				t.Fatalf("unexpected field, expected: %s, got: %s", test.expectedField, parcelError.Field)
+			}
+		})
+	}
+}
+
+func TestPredefinedPackagesFor(t *testing.T) {
+	packages := PredefinedPackagesFor(CarrierUPS)
+	if !slices.Contains(packages, "UPSLetter") {
+		t.Fatalf("unexpected packages: %q", packages)
+	}
+	packages[0] = "Changed"
+	if PredefinedPackagesFor(CarrierUPS)[0] == "Changed" {
+		t.Fatal("packages are shared")
+	}
+	if packages := PredefinedPackagesFor("Unknown"); len(packages) != 0 {
+		t.Fatalf("unexpected packages of unknown carrier: %q", packages)
+	}
+}
+
+func TestCreateParcel(t *testing.T) {
+	setup()
+
+	b, err := readTestParcelFile("prcl_1")
+	if err != nil {
+		t.Fatalf("error reading file: %s", err)
+	}
+	expectedParcel := Parcel{}
+	if err := json.Unmarshal(b, &expectedParcel); err != nil {
+		t.Fatalf("expected parcel build error: %s", err)
+	}
+
+	parcel, err := testClient.CreateParcel(Parcel{Length: 20.2, Width: 10.9, Height: 5, Weight: 65.9})
+	if err != nil {
+		t.Fatalf("not success response: %s", err)
+	}
+	if !reflect.DeepEqual(parcel, &expectedParcel) {
+		t.Fatalf("parcels: \nexpected %+v\n     got %+v", &expectedParcel, parcel)
+	}
+
+	parcel, err = testClient.GetParcel("prcl_1")
+	if err != nil {
+		t.Fatalf("not success response: %s", err)
+	}
+	if !reflect.DeepEqual(parcel, &expectedParcel) {
+		t.Fatalf("parcels: \nexpected %+v\n     got %+v", &expectedParcel, parcel)
+	}
+
+	_, err = testClient.CreateParcel(Parcel{Length: 20.2})
+	if _, ok := err.(InvalidParcelError); !ok {
+		t.Fatalf("expected InvalidParcelError, got: %T(%s)", err, err)
+	}
+}
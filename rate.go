@@ -0,0 +1,144 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypost
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Amount returns the rate as a number in Currency.
+func (r Rate) Amount() (float64, error) {
+	amount, err := strconv.ParseFloat(r.Rate, 64)
+	if err != nil {
+		return 0, fmt.Errorf("error parsing rate %s: %s", r.ID, err)
+	}
+	return amount, nil
+}
+
+// EstimatedDelivery returns the delivery date of the rate. Rates without
+// delivery date are estimated to arrive in DeliveryDays business days after
+// they were created, weekends are skipped but holidays aren't known. It
+// returns false if the carrier provided neither.
+func (r Rate) EstimatedDelivery() (time.Time, bool) {
+	if r.DeliveryDate != nil && !r.DeliveryDate.IsZero() {
+		return r.DeliveryDate.Time, true
+	}
+	if r.DeliveryDays != nil {
+		return addBusinessDays(r.CreatedAt.Time, *r.DeliveryDays), true
+	}
+	return time.Time{}, false
+}
+
+func addBusinessDays(t time.Time, days int) time.Time {
+	for days > 0 {
+		t = t.AddDate(0, 0, 1)
+		if t.Weekday() != time.Saturday && t.Weekday() != time.Sunday {
+			days--
+		}
+	}
+	return t
+}
+
+func (r Rate) matches(carriers []Carrier, services []string) bool {
+	if len(carriers) > 0 && !slices.ContainsFunc(carriers, func(c Carrier) bool { return strings.EqualFold(c.String(), r.Carrier.String()) }) {
+		return false
+	}
+	if len(services) > 0 && !slices.ContainsFunc(services, func(s string) bool { return strings.EqualFold(s, r.Service) }) {
+		return false
+	}
+	return true
+}
+
+// LowestRate returns the cheapest rate of the shipment. Empty carriers or
+// services match any carrier or service.
+func (s Shipment) LowestRate(carriers []Carrier, services []string) (*Rate, error) {
+	var lowest *Rate
+	var lowestAmount float64
+	for i := range s.Rates {
+		rate := s.Rates[i]
+		if !rate.matches(carriers, services) {
+			continue
+		}
+		amount, err := rate.Amount()
+		if err != nil {
+			return nil, err
+		}
+		if lowest == nil || amount < lowestAmount {
+			lowest, lowestAmount = &rate, amount
+		}
+	}
+	if lowest == nil {
+		return nil, RateNotFoundError{Carriers: carriers, Services: services}
+	}
+	return lowest, nil
+}
+
+// FastestRate returns the rate with the earliest estimated delivery, the
+// cheapest one if several rates arrive at the same time. Empty carriers or
+// services match any carrier or service.
+func (s Shipment) FastestRate(carriers []Carrier, services []string) (*Rate, error) {
+	var fastest *Rate
+	var fastestDelivery time.Time
+	var fastestAmount float64
+	for i := range s.Rates {
+		rate := s.Rates[i]
+		if !rate.matches(carriers, services) {
+			continue
+		}
+		delivery, ok := rate.EstimatedDelivery()
+		if !ok {
+			continue
+		}
+		amount, err := rate.Amount()
+		if err != nil {
+			return nil, err
+		}
+		if fastest == nil || delivery.Before(fastestDelivery) || (delivery.Equal(fastestDelivery) && amount < fastestAmount) {
+			fastest, fastestDelivery, fastestAmount = &rate, delivery, amount
+		}
+	}
+	if fastest == nil {
+		return nil, RateNotFoundError{Carriers: carriers, Services: services}
+	}
+	return fastest, nil
+}
+
+// LowestRateDeliveredBy returns the cheapest rate estimated to arrive no
+// later than deadline. If guaranteed is set, only rates with delivery date
+// guaranteed by the carrier are considered. Empty carriers or services
+// match any carrier or service.
+func (s Shipment) LowestRateDeliveredBy(deadline time.Time, guaranteed bool, carriers []Carrier, services []string) (*Rate, error) {
+	var lowest *Rate
+	var lowestAmount float64
+	for i := range s.Rates {
+		rate := s.Rates[i]
+		if !rate.matches(carriers, services) || (guaranteed && !rate.DeliveryDateGuaranteed) {
+			continue
+		}
+		delivery, ok := rate.EstimatedDelivery()
+		if !ok || delivery.After(deadline) {
+			continue
+		}
+		amount, err := rate.Amount()
+		if err != nil {
+			return nil, err
+		}
+		if lowest == nil || amount < lowestAmount {
+			lowest, lowestAmount = &rate, amount
+		}
+	}
+	if lowest == nil {
+		return nil, RateNotFoundError{Carriers: carriers, Services: services, DeliverBy: &deadline, Guaranteed: guaranteed}
+	}
+	return lowest, nil
+}
@@ -0,0 +1,109 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypost
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRateHelpers(t *testing.T) {
+	shipment := readTestShipment(t, "shp_1")
+	date := func(day, hour int) time.Time {
+		return time.Date(2026, 1, day, hour, 0, 0, 0, time.UTC)
+	}
+
+	for _, test := range []struct {
+		name           string
+		rate           func() (*Rate, error)
+		expectedRateID string
+	}{
+		{
+			name:           "lowest",
+			rate:           func() (*Rate, error) { return shipment.LowestRate(nil, nil) },
+			expectedRateID: "rate_3",
+		},
+		{
+			name:           "lowest of carrier",
+			rate:           func() (*Rate, error) { return shipment.LowestRate([]Carrier{"UPS"}, nil) },
+			expectedRateID: "rate_4",
+		},
+		{
+			name:           "lowest of services",
+			rate:           func() (*Rate, error) { return shipment.LowestRate([]Carrier{"usps"}, []string{"Priority", "Express"}) },
+			expectedRateID: "rate_1",
+		},
+		{
+			name: "lowest of unknown carrier",
+			rate: func() (*Rate, error) { return shipment.LowestRate([]Carrier{"FedEx"}, nil) },
+		},
+		{
+			name:           "fastest",
+			rate:           func() (*Rate, error) { return shipment.FastestRate(nil, nil) },
+			expectedRateID: "rate_2",
+		},
+		{
+			name:           "fastest of carrier",
+			rate:           func() (*Rate, error) { return shipment.FastestRate([]Carrier{"UPS"}, nil) },
+			expectedRateID: "rate_4",
+		},
+		{
+			name:           "delivered by",
+			rate:           func() (*Rate, error) { return shipment.LowestRateDeliveredBy(date(15, 0), false, nil, nil) },
+			expectedRateID: "rate_1",
+		},
+		{
+			name:           "guaranteed delivered by",
+			rate:           func() (*Rate, error) { return shipment.LowestRateDeliveredBy(date(16, 0), true, nil, nil) },
+			expectedRateID: "rate_4",
+		},
+		{
+			name: "delivered too early",
+			rate: func() (*Rate, error) { return shipment.LowestRateDeliveredBy(date(13, 0), false, nil, nil) },
+		},
+		{
+			name: "delivered by of carrier",
+			rate: func() (*Rate, error) {
+				return shipment.LowestRateDeliveredBy(date(16, 0), false, []Carrier{"UPS"}, nil)
+			},
+			expectedRateID: "rate_4",
+		},
+		{
+			name: "delivered after weekend",
+			rate: func() (*Rate, error) {
+				return shipment.LowestRateDeliveredBy(date(20, 0), false, nil, []string{"GroundAdvantage"})
+			},
+			expectedRateID: "rate_3",
+		},
+		{
+			name: "delivered by business days",
+			rate: func() (*Rate, error) {
+				return shipment.LowestRateDeliveredBy(date(18, 0), false, nil, []string{"GroundAdvantage"})
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			rate, err := test.rate()
+			if test.expectedRateID == "" {
+				var notFoundError RateNotFoundError
+				if !errors.As(err, &notFoundError) {
+					t.Fatalf("expected RateNotFoundError, got: %T(%v)", err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if rate.ID != test.expectedRateID {
+				t.Fatalf("unexpected rate, expected: %s, got: %s", test.expectedRateID, rate.ID)
+			}
+		})
+	}
+}
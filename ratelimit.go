@@ -0,0 +1,146 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypost
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket limiting the rate of requests of clients
+// using it. The rate follows X-Ratelimit-Limit response header, which is
+// treated as the number of requests allowed per second, and all requests are
+// paused for the time of Retry-After header of throttled responses. A
+// limiter without a limit doesn't limit requests until the header sets one,
+// the zero value is such a limiter with bursts of 1 request.
+type RateLimiter struct {
+	mu          sync.Mutex
+	limit       float64
+	burst       int
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+}
+
+// RateLimiterState is a snapshot of the limiter, e.g. for metrics.
+type RateLimiterState struct {
+	// Limit is the number of requests allowed per second.
+	Limit float64
+	// Burst is the maximum number of requests allowed at once.
+	Burst int
+	// Tokens is the number of requests which can be made right away.
+	Tokens float64
+	// PausedUntil is the time until requests are paused after a throttled
+	// response, zero if they aren't.
+	PausedUntil time.Time
+}
+
+// NewRateLimiter returns a limiter allowing limit requests per second, with
+// bursts of up to burst requests. A limit of 0 makes the limiter learn the
+// limit from response headers.
+func NewRateLimiter(limit float64, burst int) *RateLimiter {
+	burst = max(burst, 1)
+	return &RateLimiter{
+		limit:  limit,
+		burst:  burst,
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// WithRateLimiter makes the Client wait for l before every request, including
+// retries. A limiter can be shared by several clients.
+func WithRateLimiter(l *RateLimiter) Option {
+	return func(o *clientOptions) {
+		o.rateLimiter = l
+	}
+}
+
+// Wait blocks until a request is allowed or ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		delay := l.reserve(time.Now())
+		if delay == 0 {
+			return nil
+		}
+		t := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// reserve takes a token and returns 0 if one is available, otherwise it
+// returns the time to wait before trying again.
+func (l *RateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refill(now)
+	if now.Before(l.pausedUntil) {
+		return l.pausedUntil.Sub(now)
+	}
+	if l.limit <= 0 {
+		return 0
+	}
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+	return time.Duration((1 - l.tokens) / l.limit * float64(time.Second))
+}
+
+func (l *RateLimiter) refill(now time.Time) {
+	if now.After(l.last) {
+		l.tokens = min(l.tokens+now.Sub(l.last).Seconds()*l.limit, float64(l.burst))
+		l.last = now
+	}
+}
+
+// update adjusts the limiter to rate limit headers of the response.
+func (l *RateLimiter) update(statusCode int, header http.Header) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.refill(now)
+	if limit, err := strconv.ParseFloat(header.Get("X-Ratelimit-Limit"), 64); err == nil && limit > 0 {
+		l.limit = limit
+		l.burst = max(l.burst, 1)
+	}
+	if statusCode == http.StatusTooManyRequests || statusCode == http.StatusServiceUnavailable {
+		if d, ok := parseRetryAfter(header.Get("Retry-After")); ok && now.Add(d).After(l.pausedUntil) {
+			l.pausedUntil = now.Add(d)
+		}
+	}
+}
+
+// State returns the current state of the limiter.
+func (l *RateLimiter) State() RateLimiterState {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.refill(now)
+	state := RateLimiterState{
+		Limit:  l.limit,
+		Burst:  l.burst,
+		Tokens: l.tokens,
+	}
+	if now.Before(l.pausedUntil) {
+		state.PausedUntil = l.pausedUntil
+	}
+	return state
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypost
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	l := NewRateLimiter(10, 2)
+	now := l.last
+
+	for i := 0; i < 2; i++ {
+		if d := l.reserve(now); d != 0 {
+			t.Fatalf("request %d: unexpected delay: %s", i, d)
+		}
+	}
+	if d := l.reserve(now); d != 100*time.Millisecond {
+		t.Fatalf("unexpected delay, expected: 100ms, got: %s", d)
+	}
+	if d := l.reserve(now.Add(100 * time.Millisecond)); d != 0 {
+		t.Fatalf("unexpected delay after refill: %s", d)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := l.Wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("context canceled error expected, got: %v", err)
+	}
+}
+
+func TestRateLimiterHeaders(t *testing.T) {
+	throttle := false
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Ratelimit-Limit", "50")
+		if throttle {
+			w.Header().Set("Retry-After", "60")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"object": "Tracker"}`))
+	}))
+	defer s.Close()
+
+	l := NewRateLimiter(1, 5)
+	c := NewClient("", WithBaseURL(s.URL), WithRateLimiter(l))
+
+	if _, err := c.CreateTracker("EZ3000000003", ""); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	state := l.State()
+	if state.Limit != 50 || state.Burst != 5 || !state.PausedUntil.IsZero() {
+		t.Fatalf("unexpected state: %+v", state)
+	}
+
+	throttle = true
+	if _, err := c.CreateTracker("EZ3000000003", ""); err == nil {
+		t.Fatal("error expected")
+	}
+	if state := l.State(); time.Until(state.PausedUntil) < 50*time.Second {
+		t.Fatalf("unexpected state: %+v", state)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := c.CreateTrackerContext(ctx, "EZ3000000003", ""); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("deadline exceeded error expected, got: %v", err)
+	}
+}
+
+func TestRateLimiterWithoutLimit(t *testing.T) {
+	for name, l := range map[string]*RateLimiter{
+		"zero value": {},
+		"zero limit": NewRateLimiter(0, 1),
+		"negative":   NewRateLimiter(-1, 1),
+	} {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		for i := 0; i < 3; i++ {
+			if err := l.Wait(ctx); err != nil {
+				t.Fatalf("%s: request %d: %v", name, i, err)
+			}
+		}
+		cancel()
+
+		header := http.Header{}
+		header.Set("X-Ratelimit-Limit", "10")
+		l.update(http.StatusOK, header)
+		now := time.Now()
+		l.reserve(now)
+		if d := l.reserve(now); d <= 0 || d > 100*time.Millisecond {
+			t.Fatalf("%s: unexpected delay after limit is set: %s", name, d)
+		}
+	}
+}
@@ -11,11 +11,20 @@ package easypost
 const (
 	RecordTypeAddress          RecordType = "Address"
 	RecordTypeCarrierDetail    RecordType = "CarrierDetail"
+	RecordTypeCustomsInfo      RecordType = "CustomsInfo"
+	RecordTypeCustomsItem      RecordType = "CustomsItem"
 	RecordTypeEvent            RecordType = "Event"
 	RecordTypeFee              RecordType = "Fee"
+	RecordTypeParcel           RecordType = "Parcel"
+	RecordTypePayload          RecordType = "Payload"
+	RecordTypePostageLabel     RecordType = "PostageLabel"
+	RecordTypeRate             RecordType = "Rate"
+	RecordTypeRefund           RecordType = "Refund"
+	RecordTypeShipment         RecordType = "Shipment"
 	RecordTypeTracker          RecordType = "Tracker"
 	RecordTypeTrackingDetail   RecordType = "TrackingDetail"
 	RecordTypeTrackingLocation RecordType = "TrackingLocation"
+	RecordTypeWebhook          RecordType = "Webhook"
 )
 
 type RecordType string
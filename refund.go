@@ -0,0 +1,86 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypost
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+type RefundStatus string
+
+const (
+	RefundStatusSubmitted     RefundStatus = "submitted"
+	RefundStatusRefunded      RefundStatus = "refunded"
+	RefundStatusRejected      RefundStatus = "rejected"
+	RefundStatusNotApplicable RefundStatus = "not_applicable"
+)
+
+// Final reports whether the carrier made the decision on the refund.
+func (s RefundStatus) Final() bool {
+	switch s {
+	case RefundStatusRefunded, RefundStatusRejected, RefundStatusNotApplicable:
+		return true
+	}
+	return false
+}
+
+type Refund struct {
+	ID                 string       `json:"id"`
+	Object             RecordType   `json:"object"`
+	Mode               string       `json:"mode"`
+	TrackingCode       string       `json:"tracking_code"`
+	ConfirmationNumber *string      `json:"confirmation_number"`
+	Status             RefundStatus `json:"status"`
+	Carrier            Carrier      `json:"carrier"`
+	ShipmentID         string       `json:"shipment_id"`
+	CreatedAt          DateTime     `json:"created_at"`
+	UpdatedAt          DateTime     `json:"updated_at"`
+}
+
+// RefundShipment requests refund of unused postage of the shipment. The
+// returned shipment has RefundStatus set, the final status is reported with
+// refund events.
+func (c *Client) RefundShipment(shipmentID string) (*Shipment, error) {
+	return c.RefundShipmentContext(context.Background(), shipmentID)
+}
+
+func (c *Client) RefundShipmentContext(ctx context.Context, shipmentID string) (*Shipment, error) {
+	responseBody, err := c.post(ctx, objectPath(shipmentURL, shipmentID, "refund"), nil)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[Shipment](responseBody)
+}
+
+// CreateRefunds requests refunds of postage bought with the carrier for all
+// tracking codes at once.
+func (c *Client) CreateRefunds(carrier Carrier, trackingCodes []string) ([]Refund, error) {
+	return c.CreateRefundsContext(context.Background(), carrier, trackingCodes)
+}
+
+func (c *Client) CreateRefundsContext(ctx context.Context, carrier Carrier, trackingCodes []string) ([]Refund, error) {
+	parameters := url.Values{}
+	parameters.Set("refund[carrier]", carrier.String())
+	for _, trackingCode := range trackingCodes {
+		parameters.Add("refund[tracking_codes][]", trackingCode)
+	}
+
+	responseBody, err := c.post(ctx, refundURL, parameters)
+	if err != nil {
+		return nil, err
+	}
+	var refunds []Refund
+	if err := json.Unmarshal(responseBody, &refunds); err != nil {
+		return nil, fmt.Errorf("error decode response: %s", err)
+	}
+	return refunds, nil
+}
@@ -0,0 +1,47 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypost
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRefundShipment(t *testing.T) {
+	setup()
+
+	shipment, err := testClient.RefundShipment("shp_1")
+	if err != nil {
+		t.Fatalf("not success response: %s", err)
+	}
+	if shipment.RefundStatus != RefundStatusSubmitted || shipment.RefundStatus.Final() {
+		t.Fatalf("unexpected refund status: %s", shipment.RefundStatus)
+	}
+}
+
+func TestCreateRefunds(t *testing.T) {
+	setup()
+
+	trackingCodes := []string{"9400100105442285812345", "9400100105442285812346"}
+	refunds, err := testClient.CreateRefunds(CarrierUSPS, trackingCodes)
+	if err != nil {
+		t.Fatalf("not success response: %s", err)
+	}
+
+	var gotTrackingCodes []string
+	for _, refund := range refunds {
+		if refund.Carrier != CarrierUSPS || refund.Status != RefundStatusSubmitted {
+			t.Errorf("unexpected refund: %+v", refund)
+		}
+		gotTrackingCodes = append(gotTrackingCodes, refund.TrackingCode)
+	}
+	if !reflect.DeepEqual(gotTrackingCodes, trackingCodes) {
+		t.Fatalf("tracking codes: \nexpected %v\n     got %v", trackingCodes, gotTrackingCodes)
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypost
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const requestIDHeader = "X-Ep-Request-Uuid"
+
+// ResponseInfo is metadata of an EasyPost API response. Provide RequestID to
+// EasyPost support when asking about a failed request.
+type ResponseInfo struct {
+	RequestID  string
+	StatusCode int
+	// Latency is the time from sending the request until the response body
+	// is read.
+	Latency time.Duration
+	// RateLimit and RateLimitRemaining are values of X-Ratelimit-Limit and
+	// X-Ratelimit-Remaining headers, zero if they are missing.
+	RateLimit          int
+	RateLimitRemaining int
+	// RetryAfter is the value of Retry-After header, zero if it is missing.
+	RetryAfter time.Duration
+}
+
+func newResponseInfo(response *http.Response, latency time.Duration) ResponseInfo {
+	info := ResponseInfo{
+		RequestID:  response.Header.Get(requestIDHeader),
+		StatusCode: response.StatusCode,
+		Latency:    latency,
+	}
+	info.RateLimit, _ = strconv.Atoi(response.Header.Get("X-Ratelimit-Limit"))
+	info.RateLimitRemaining, _ = strconv.Atoi(response.Header.Get("X-Ratelimit-Remaining"))
+	info.RetryAfter, _ = parseRetryAfter(response.Header.Get("Retry-After"))
+	return info
+}
+
+// responseInfo makes all errors embedding ResponseInfo carry it.
+func (i ResponseInfo) responseInfo() ResponseInfo {
+	return i
+}
+
+// ResponseInfoFromError returns metadata of the response which caused err.
+// It returns false if err didn't come from an EasyPost response, e.g. a
+// TransportError.
+func ResponseInfoFromError(err error) (ResponseInfo, bool) {
+	var e interface{ responseInfo() ResponseInfo }
+	if !errors.As(err, &e) {
+		return ResponseInfo{}, false
+	}
+	info := e.responseInfo()
+	return info, info.StatusCode != 0
+}
+
+type responseInfoKey struct{}
+
+type responseInfoTarget struct {
+	mu   sync.Mutex
+	info *ResponseInfo
+}
+
+// WithResponseInfo returns a context which makes calls of the Client store
+// metadata of the response into info. If the request is retried, info holds
+// the last response. info isn't modified if no response was received. The
+// context can be shared by concurrent calls, e.g. of FetchTrackers; info
+// then holds the response received last and must be read only after all the
+// calls return.
+func WithResponseInfo(ctx context.Context, info *ResponseInfo) context.Context {
+	return context.WithValue(ctx, responseInfoKey{}, &responseInfoTarget{info: info})
+}
+
+func storeResponseInfo(ctx context.Context, info ResponseInfo) {
+	if t, ok := ctx.Value(responseInfoKey{}).(*responseInfoTarget); ok && t.info != nil {
+		t.mu.Lock()
+		*t.info = info
+		t.mu.Unlock()
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypost
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWithResponseInfo(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(requestIDHeader, "req-1")
+		w.Header().Set("X-Ratelimit-Limit", "5")
+		w.Header().Set("X-Ratelimit-Remaining", "4")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id": "trk_1", "object": "Tracker"}`))
+	}))
+	defer s.Close()
+	c := NewClient("", WithBaseURL(s.URL))
+
+	var info ResponseInfo
+	if _, err := c.CreateTrackerContext(WithResponseInfo(context.Background(), &info), "EZ3000000003", ""); err != nil {
+		t.Fatal(err)
+	}
+	if info.RequestID != "req-1" || info.StatusCode != http.StatusCreated || info.RateLimit != 5 || info.RateLimitRemaining != 4 {
+		t.Fatalf("unexpected response info: %+v", info)
+	}
+	if info.Latency <= 0 {
+		t.Fatalf("latency expected: %+v", info)
+	}
+}
+
+func TestWithResponseInfoConcurrent(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(requestIDHeader, "req-"+r.FormValue("tracker[tracking_code]"))
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id": "trk_1", "object": "Tracker"}`))
+	}))
+	defer s.Close()
+
+	var info ResponseInfo
+	ctx := WithResponseInfo(context.Background(), &info)
+	c := NewClient("", WithBaseURL(s.URL))
+	var requests []TrackerRequest
+	for _, code := range []string{"1", "2", "3", "4"} {
+		requests = append(requests, TrackerRequest{TrackingCode: code})
+	}
+	for result := range c.FetchTrackers(ctx, requests, 4) {
+		if result.Err != nil {
+			t.Fatal(result.Err)
+		}
+	}
+	if info.StatusCode != http.StatusCreated || len(info.RequestID) != len("req-1") {
+		t.Fatalf("unexpected response info: %+v", info)
+	}
+}
+
+func TestResponseInfoFromError(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(requestIDHeader, "req-2")
+		w.Header().Set("Retry-After", "3")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{}`))
+	}))
+	defer s.Close()
+	c := NewClient("", WithBaseURL(s.URL))
+
+	var info ResponseInfo
+	_, err := c.CreateTrackerContext(WithResponseInfo(context.Background(), &info), "EZ3000000003", "")
+	errInfo, ok := ResponseInfoFromError(err)
+	if !ok {
+		t.Fatalf("response info expected: %v", err)
+	}
+	if errInfo != info {
+		t.Fatalf("error info differs from context one:\n%+v\n%+v", errInfo, info)
+	}
+	if errInfo.RequestID != "req-2" || errInfo.StatusCode != http.StatusTooManyRequests || errInfo.RetryAfter != 3*time.Second {
+		t.Fatalf("unexpected response info: %+v", errInfo)
+	}
+
+	if _, ok := ResponseInfoFromError(TransportError{Err: errors.New("connection reset")}); ok {
+		t.Fatal("transport error has no response info")
+	}
+	if _, ok := ResponseInfoFromError(unauthorizedError); ok {
+		t.Fatal("sentinel error has no response info")
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypost
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// defaultRetryableCodes are error codes of transient EasyPost failures.
+var defaultRetryableCodes = []ErrorCode{
+	AddressVerifyUnavailable,
+	AddressVerifyUpstreamUnavailable,
+	AddressVerificationEngineUnavailable,
+	AddressVerificationTimedOut,
+	AddressVerificationTimeZoneUnavailable,
+}
+
+// RetryPolicy controls how a Client repeats failed requests. Only safe
+// requests and requests known to be idempotent are retried. A request is
+// retried on transport errors, 429 and 5xx responses and on processing
+// errors with one of RetryableCodes.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry, it doubles with
+	// every next retry. Requests are retried right away if it is 0.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between retries. A request isn't retried
+	// when its Retry-After response header asks to wait longer than that.
+	MaxBackoff time.Duration
+	// Jitter is the fraction of the delay, between 0 and 1, which is
+	// randomized to spread retries of concurrent callers.
+	Jitter float64
+	// RetryableCodes are error codes of processing errors to retry.
+	RetryableCodes []ErrorCode
+}
+
+// DefaultRetryPolicy makes up to 3 attempts with backoff starting at 500ms.
+// It retries error codes of transient address verification failures, every
+// call returns a new copy of them.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     10 * time.Second,
+		Jitter:         0.5,
+		RetryableCodes: slices.Clone(defaultRetryableCodes),
+	}
+}
+
+// WithRetryPolicy enables retries of failed requests. Requests aren't
+// retried by default.
+func WithRetryPolicy(p RetryPolicy) Option {
+	return func(o *clientOptions) {
+		o.retryPolicy = &p
+	}
+}
+
+// delay returns how long to wait before the attempt following the failed
+// one, and whether the request should be retried at all. header is nil if
+// the request didn't reach the server.
+func (p RetryPolicy) delay(attempt int, header http.Header, err error) (time.Duration, bool) {
+	if !p.retryable(err) {
+		return 0, false
+	}
+	if d, ok := parseRetryAfter(header.Get("Retry-After")); ok {
+		if p.MaxBackoff > 0 && d > p.MaxBackoff {
+			return 0, false
+		}
+		return d, true
+	}
+
+	d := max(p.InitialBackoff, 0)
+	// Doubling stops before it can overflow.
+	for i := 1; i < attempt && d <= math.MaxInt64/2 && (p.MaxBackoff <= 0 || d < p.MaxBackoff); i++ {
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	if p.Jitter > 0 {
+		d -= time.Duration(p.Jitter * rand.Float64() * float64(d))
+	}
+	return d, true
+}
+
+func (p RetryPolicy) retryable(err error) bool {
+	var (
+		transportError TransportError
+		rateLimitError RateLimitError
+		serverError    ServerError
+	)
+	if errors.As(err, &transportError) || errors.As(err, &rateLimitError) || errors.As(err, &serverError) {
+		return true
+	}
+	return slices.ContainsFunc(p.RetryableCodes, func(code ErrorCode) bool {
+		return errors.Is(err, code)
+	})
+}
+
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		return max(time.Until(t), 0), true
+	}
+	return 0, false
+}
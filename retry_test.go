@@ -0,0 +1,198 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypost
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// newFailingServer returns a server which responds with failure to the first
+// failures requests and with the tracker to the rest of them.
+func newFailingServer(t *testing.T, failures int, failure func(w http.ResponseWriter)) (*httptest.Server, *int) {
+	requests := 0
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests <= failures {
+			failure(w)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id": "trk_1", "object": "Tracker"}`))
+	}))
+	t.Cleanup(s.Close)
+	return s, &requests
+}
+
+func testRetryPolicy() RetryPolicy {
+	p := DefaultRetryPolicy()
+	p.InitialBackoff = time.Millisecond
+	p.MaxBackoff = 5 * time.Millisecond
+	return p
+}
+
+func TestRetry(t *testing.T) {
+	for _, test := range []struct {
+		name             string
+		failures         int
+		failure          func(w http.ResponseWriter)
+		expectedRequests int
+		expectedError    bool
+	}{
+		{
+			name:     "server error",
+			failures: 2,
+			failure: func(w http.ResponseWriter) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			},
+			expectedRequests: 3,
+		},
+		{
+			name:     "rate limit",
+			failures: 1,
+			failure: func(w http.ResponseWriter) {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusTooManyRequests)
+			},
+			expectedRequests: 2,
+		},
+		{
+			name:     "retryable code",
+			failures: 1,
+			failure: func(w http.ResponseWriter) {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(ErrorResponse{
+					Error: errorMessage{Code: string(AddressVerifyUpstreamUnavailable)},
+				})
+			},
+			expectedRequests: 2,
+		},
+		{
+			name:     "not retryable code",
+			failures: 1,
+			failure: func(w http.ResponseWriter) {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(ErrorResponse{
+					Error: errorMessage{Code: string(AddressNotFound)},
+				})
+			},
+			expectedRequests: 1,
+			expectedError:    true,
+		},
+		{
+			name:     "too many failures",
+			failures: 3,
+			failure: func(w http.ResponseWriter) {
+				w.WriteHeader(http.StatusBadGateway)
+			},
+			expectedRequests: 3,
+			expectedError:    true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			s, requests := newFailingServer(t, test.failures, test.failure)
+			c := NewClient("", WithBaseURL(s.URL), WithRetryPolicy(testRetryPolicy()))
+
+			_, err := c.CreateTracker("EZ3000000003", "")
+			if test.expectedError != (err != nil) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if *requests != test.expectedRequests {
+				t.Fatalf("unexpected number of requests, expected: %d, got: %d", test.expectedRequests, *requests)
+			}
+		})
+	}
+}
+
+func TestRetryNotIdempotent(t *testing.T) {
+	s, requests := newFailingServer(t, 1, func(w http.ResponseWriter) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	c := NewClient("", WithBaseURL(s.URL), WithRetryPolicy(testRetryPolicy()))
+
+	if _, err := c.post(context.Background(), trackerURL, nil); err == nil {
+		t.Fatal("error expected")
+	}
+	if *requests != 1 {
+		t.Fatalf("unexpected number of requests, expected: 1, got: %d", *requests)
+	}
+}
+
+func TestRetryDeadline(t *testing.T) {
+	s, requests := newFailingServer(t, 1, func(w http.ResponseWriter) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	c := NewClient("", WithBaseURL(s.URL), WithRetryPolicy(testRetryPolicy()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if _, err := c.CreateTrackerContext(ctx, "EZ3000000003", ""); err == nil {
+		t.Fatal("error expected")
+	}
+	if *requests != 1 {
+		t.Fatalf("unexpected number of requests, expected: 1, got: %d", *requests)
+	}
+}
+
+func TestRetryAfterAboveMaxBackoff(t *testing.T) {
+	s, requests := newFailingServer(t, 1, func(w http.ResponseWriter) {
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	c := NewClient("", WithBaseURL(s.URL), WithRetryPolicy(testRetryPolicy()))
+
+	start := time.Now()
+	_, err := c.CreateTracker("EZ3000000003", "")
+	var rateLimitError RateLimitError
+	if !errors.As(err, &rateLimitError) || rateLimitError.RetryAfter != time.Hour {
+		t.Fatalf("rate limit error expected, got: %v", err)
+	}
+	if *requests != 1 {
+		t.Fatalf("unexpected number of requests, expected: 1, got: %d", *requests)
+	}
+	if d := time.Since(start); d > time.Second {
+		t.Fatalf("request took too long: %s", d)
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	serverError := ServerError{}
+	for _, test := range []struct {
+		name     string
+		policy   RetryPolicy
+		attempt  int
+		expected time.Duration
+	}{
+		{"first", RetryPolicy{InitialBackoff: time.Second, MaxBackoff: time.Minute}, 1, time.Second},
+		{"doubled", RetryPolicy{InitialBackoff: time.Second, MaxBackoff: time.Minute}, 3, 4 * time.Second},
+		{"capped", RetryPolicy{InitialBackoff: time.Second, MaxBackoff: time.Minute}, 10, time.Minute},
+		{"no initial backoff", RetryPolicy{MaxBackoff: 3 * time.Second}, 2, 0},
+		{"large attempt", RetryPolicy{InitialBackoff: time.Second, MaxBackoff: time.Minute}, 100, time.Minute},
+		{"large attempt without max", RetryPolicy{InitialBackoff: 3 * time.Second}, 100, 3 * time.Second << 31},
+	} {
+		d, ok := test.policy.delay(test.attempt, nil, serverError)
+		if !ok || d != test.expected {
+			t.Fatalf("%s: unexpected delay, expected: %s, got: %s (%t)", test.name, test.expected, d, ok)
+		}
+	}
+}
+
+func TestDefaultRetryPolicyCodes(t *testing.T) {
+	p := DefaultRetryPolicy()
+	p.RetryableCodes[0] = "CHANGED"
+	if code := DefaultRetryPolicy().RetryableCodes[0]; code == "CHANGED" {
+		t.Fatal("retryable codes are shared by default policies")
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypost
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+type Shipment struct {
+	ID            string            `json:"id"`
+	Object        RecordType        `json:"object"`
+	Mode          string            `json:"mode"`
+	Reference     string            `json:"reference"`
+	Status        string            `json:"status"`
+	IsReturn      bool              `json:"is_return"`
+	ToAddress     *Address          `json:"to_address"`
+	FromAddress   *Address          `json:"from_address"`
+	ReturnAddress *Address          `json:"return_address"`
+	BuyerAddress  *Address          `json:"buyer_address"`
+	Parcel        *Parcel           `json:"parcel"`
+	CustomsInfo   *CustomsInfo      `json:"customs_info"`
+	Rates         []Rate            `json:"rates"`
+	SelectedRate  *Rate             `json:"selected_rate"`
+	PostageLabel  *PostageLabel     `json:"postage_label"`
+	Tracker       *Tracker          `json:"tracker"`
+	TrackingCode  string            `json:"tracking_code"`
+	RefundStatus  RefundStatus      `json:"refund_status"`
+	Messages      []ShipmentMessage `json:"messages"`
+	Fees          []Fee             `json:"fees"`
+	CreatedAt     DateTime          `json:"created_at"`
+	UpdatedAt     DateTime          `json:"updated_at"`
+}
+
+// ShipmentMessage reports a carrier which failed to provide rates.
+type ShipmentMessage struct {
+	Carrier string `json:"carrier"`
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+type Rate struct {
+	ID                     string     `json:"id"`
+	Object                 RecordType `json:"object"`
+	Mode                   string     `json:"mode"`
+	Service                string     `json:"service"`
+	Carrier                Carrier    `json:"carrier"`
+	CarrierAccountID       string     `json:"carrier_account_id"`
+	ShipmentID             string     `json:"shipment_id"`
+	Rate                   string     `json:"rate"`
+	Currency               string     `json:"currency"`
+	RetailRate             string     `json:"retail_rate"`
+	RetailCurrency         string     `json:"retail_currency"`
+	ListRate               string     `json:"list_rate"`
+	ListCurrency           string     `json:"list_currency"`
+	DeliveryDays           *int       `json:"delivery_days"`
+	DeliveryDate           *DateTime  `json:"delivery_date"`
+	DeliveryDateGuaranteed bool       `json:"delivery_date_guaranteed"`
+	EstDeliveryDays        *int       `json:"est_delivery_days"`
+	CreatedAt              DateTime   `json:"created_at"`
+	UpdatedAt              DateTime   `json:"updated_at"`
+}
+
+type PostageLabel struct {
+	ID              string     `json:"id"`
+	Object          RecordType `json:"object"`
+	LabelDate       *DateTime  `json:"label_date"`
+	LabelResolution int        `json:"label_resolution"`
+	LabelSize       string     `json:"label_size"`
+	LabelType       string     `json:"label_type"`
+	LabelFileType   string     `json:"label_file_type"`
+	LabelURL        string     `json:"label_url"`
+	LabelPDFURL     string     `json:"label_pdf_url"`
+	LabelZPLURL     string     `json:"label_zpl_url"`
+	LabelEPL2URL    string     `json:"label_epl2_url"`
+	CreatedAt       DateTime   `json:"created_at"`
+	UpdatedAt       DateTime   `json:"updated_at"`
+}
+
+// CreateShipment creates shipment and returns it with rates of all carriers
+// configured in the account. Addresses and parcel of shipment are created
+// along with it unless they have an ID. The same applies to customs info,
+// required for international shipments. New parcel and customs info are
+// validated first.
+func (c *Client) CreateShipment(shipment Shipment) (*Shipment, error) {
+	return c.CreateShipmentContext(context.Background(), shipment)
+}
+
+func (c *Client) CreateShipmentContext(ctx context.Context, shipment Shipment) (*Shipment, error) {
+	parameters := url.Values{}
+	if shipment.ToAddress != nil {
+		shipment.ToAddress.encodeReference("shipment[to_address]", parameters)
+	}
+	if shipment.FromAddress != nil {
+		shipment.FromAddress.encodeReference("shipment[from_address]", parameters)
+	}
+	if shipment.ReturnAddress != nil {
+		shipment.ReturnAddress.encodeReference("shipment[return_address]", parameters)
+	}
+	if shipment.BuyerAddress != nil {
+		shipment.BuyerAddress.encodeReference("shipment[buyer_address]", parameters)
+	}
+	if shipment.Parcel != nil {
+		if shipment.Parcel.ID == "" {
+			if err := shipment.Parcel.Validate(); err != nil {
+				return nil, err
+			}
+		}
+		shipment.Parcel.encode("shipment[parcel]", parameters)
+	}
+	if shipment.CustomsInfo != nil {
+		if shipment.CustomsInfo.ID == "" {
+			if err := shipment.CustomsInfo.Validate(); err != nil {
+				return nil, err
+			}
+		}
+		shipment.CustomsInfo.encode("shipment[customs_info]", parameters)
+	}
+	if shipment.Reference != "" {
+		parameters.Set("shipment[reference]", shipment.Reference)
+	}
+	if shipment.IsReturn {
+		parameters.Set("shipment[is_return]", strconv.FormatBool(shipment.IsReturn))
+	}
+
+	responseBody, err := c.post(ctx, shipmentURL, parameters)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[Shipment](responseBody)
+}
+
+func (c *Client) GetShipment(id string) (*Shipment, error) {
+	return c.GetShipmentContext(context.Background(), id)
+}
+
+func (c *Client) GetShipmentContext(ctx context.Context, id string) (*Shipment, error) {
+	responseBody, err := c.get(ctx, objectPath(shipmentURL, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[Shipment](responseBody)
+}
+
+// BuyShipment purchases postage of the shipment with the rate, which must be
+// one of the shipment rates. The returned shipment has PostageLabel and
+// Tracker.
+func (c *Client) BuyShipment(shipmentID string, rate Rate) (*Shipment, error) {
+	return c.BuyShipmentContext(context.Background(), shipmentID, rate)
+}
+
+func (c *Client) BuyShipmentContext(ctx context.Context, shipmentID string, rate Rate) (*Shipment, error) {
+	parameters := url.Values{}
+	parameters.Set("rate[id]", rate.ID)
+
+	responseBody, err := c.post(ctx, objectPath(shipmentURL, shipmentID, "buy"), parameters)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[Shipment](responseBody)
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypost
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func readTestShipment(t *testing.T, name string) *Shipment {
+	t.Helper()
+	b, err := readTestShipmentFile(name)
+	if err != nil {
+		t.Fatalf("error reading file: %s", err)
+	}
+	shipment := &Shipment{}
+	if err := json.Unmarshal(b, shipment); err != nil {
+		t.Fatalf("expected shipment build error: %s", err)
+	}
+	return shipment
+}
+
+func TestCreateShipment(t *testing.T) {
+	setup()
+
+	name := "Dr. Steve Brule"
+	shipment, err := testClient.CreateShipment(Shipment{
+		ToAddress: &Address{
+			Street1: "417 Montgomery St",
+			City:    "San Francisco",
+			Country: "US",
+			Name:    &name,
+		},
+		FromAddress: &Address{ID: "adr_from1"},
+		Parcel:      &Parcel{Weight: 65.9},
+	})
+	if err != nil {
+		t.Fatalf("not success response: %s", err)
+	}
+	expectedShipment := readTestShipment(t, "shp_1")
+	if !reflect.DeepEqual(shipment, expectedShipment) {
+		t.Fatalf("shipments: \nexpected %+v\n     got %+v", expectedShipment, shipment)
+	}
+
+	_, err = testClient.CreateShipment(Shipment{})
+	if _, ok := err.(ProcessingError); !ok {
+		t.Fatalf("expected ProcessingError, got: %T(%s)", err, err)
+	}
+}
+
+func TestGetShipment(t *testing.T) {
+	setup()
+
+	shipment, err := testClient.GetShipment("shp_1")
+	if err != nil {
+		t.Fatalf("not success response: %s", err)
+	}
+	expectedShipment := readTestShipment(t, "shp_1")
+	if !reflect.DeepEqual(shipment, expectedShipment) {
+		t.Fatalf("shipments: \nexpected %+v\n     got %+v", expectedShipment, shipment)
+	}
+
+	if _, err := testClient.GetShipment("shp_2"); err == nil {
+		t.Fatal("error expected")
+	}
+}
+
+func TestBuyShipment(t *testing.T) {
+	setup()
+
+	shipment := readTestShipment(t, "shp_1")
+	boughtShipment, err := testClient.BuyShipment(shipment.ID, shipment.Rates[0])
+	if err != nil {
+		t.Fatalf("not success response: %s", err)
+	}
+	if boughtShipment.PostageLabel == nil || boughtShipment.Tracker == nil {
+		t.Fatalf("missing postage label or tracker: %+v", boughtShipment)
+	}
+	if !reflect.DeepEqual(boughtShipment.SelectedRate, &shipment.Rates[0]) {
+		t.Fatalf("selected rate: \nexpected %+v\n     got %+v", shipment.Rates[0], boughtShipment.SelectedRate)
+	}
+
+	_, err = testClient.BuyShipment(shipment.ID, shipment.Rates[1])
+	if _, ok := err.(ProcessingError); !ok {
+		t.Fatalf("expected ProcessingError, got: %T(%s)", err, err)
+	}
+}
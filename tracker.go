@@ -9,8 +9,10 @@
 package easypost
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"iter"
+	"net/http"
 	"net/url"
 	"time"
 )
@@ -36,6 +38,13 @@ var (
 
 type Carrier string
 
+const (
+	CarrierUSPS       Carrier = "USPS"
+	CarrierUPS        Carrier = "UPS"
+	CarrierFedEx      Carrier = "FedEx"
+	CarrierDHLExpress Carrier = "DHLExpress"
+)
+
 func (c Carrier) String() string { return string(c) }
 
 type Tracker struct {
@@ -83,11 +92,11 @@ type TrackingLocation struct {
 }
 
 type CarrierDetails struct {
-	Object                      RecordType        `json:"object"`
-	Service                     string            `json:"service"`
-	ContainerType               string            `json:"container_type"`
-	estDeliveryDateLocal        *DateTime         `json:"est_delivery_date_local,omitempty"`
-	estDeliveryTimeLocal        *localTime        `json:"est_delivery_time_local,omitempty"`
+	Object                      RecordType `json:"object"`
+	Service                     string     `json:"service"`
+	ContainerType               string     `json:"container_type"`
+	estDeliveryDateLocal        *DateTime
+	estDeliveryTimeLocal        *localTime
 	OriginLocation              string            `json:"origin_location"`
 	OriginTrackingLocation      *TrackingLocation `json:"origin_tracking_location,omitempty"`
 	DestinationLocation         string            `json:"destination_location"`
@@ -145,21 +154,103 @@ func (c CarrierDetails) EstimatedDeliveryTime() *time.Time {
 	return &t
 }
 
-func (c *Client) GetTracker(trackingCode string, carrier Carrier) (*Tracker, error) {
+// CreateTracker starts tracking of the shipment with the tracking code. If the
+// tracker already exists, EasyPost returns it instead of creating a new one.
+// Carrier is optional, EasyPost detects it by the tracking code otherwise.
+func (c *Client) CreateTracker(trackingCode string, carrier Carrier) (*Tracker, error) {
+	return c.CreateTrackerContext(context.Background(), trackingCode, carrier)
+}
+
+func (c *Client) CreateTrackerContext(ctx context.Context, trackingCode string, carrier Carrier) (*Tracker, error) {
 	parameters := url.Values{}
 	parameters.Set("tracker[tracking_code]", trackingCode)
 	if carrier != "" {
 		parameters.Set("tracker[carrier]", carrier.String())
 	}
-	parameters.Encode()
-	responseBody, err := c.post(trackerURL, parameters)
+	// EasyPost returns the existing tracker for already known tracking
+	// code, so the request is safe to retry.
+	responseBody, err := c.do(ctx, apiRequest{
+		method:     http.MethodPost,
+		path:       trackerURL,
+		parameters: parameters,
+		idempotent: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[Tracker](responseBody)
+}
+
+// GetTracker creates the tracker.
+//
+// Deprecated: use CreateTracker, or RetrieveTracker to get an existing
+// tracker without creating it.
+func (c *Client) GetTracker(trackingCode string, carrier Carrier) (*Tracker, error) {
+	return c.CreateTrackerContext(context.Background(), trackingCode, carrier)
+}
+
+// GetTrackerContext creates the tracker.
+//
+// Deprecated: use CreateTrackerContext, or RetrieveTrackerContext to get an
+// existing tracker without creating it.
+func (c *Client) GetTrackerContext(ctx context.Context, trackingCode string, carrier Carrier) (*Tracker, error) {
+	return c.CreateTrackerContext(ctx, trackingCode, carrier)
+}
+
+// RetrieveTracker returns the existing tracker with id.
+func (c *Client) RetrieveTracker(id string) (*Tracker, error) {
+	return c.RetrieveTrackerContext(context.Background(), id)
+}
+
+func (c *Client) RetrieveTrackerContext(ctx context.Context, id string) (*Tracker, error) {
+	responseBody, err := c.get(ctx, objectPath(trackerURL, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[Tracker](responseBody)
+}
+
+type TrackerListParams struct {
+	ListOptions
+	TrackingCode string
+	Carrier      Carrier
+}
+
+type TrackerList struct {
+	Trackers []Tracker `json:"trackers"`
+	HasMore  bool      `json:"has_more"`
+}
+
+// ListTrackers returns a page of existing trackers matching params.
+func (c *Client) ListTrackers(params TrackerListParams) (*TrackerList, error) {
+	return c.ListTrackersContext(context.Background(), params)
+}
+
+func (c *Client) ListTrackersContext(ctx context.Context, params TrackerListParams) (*TrackerList, error) {
+	parameters := params.encode()
+	params.ListOptions.encode(parameters)
+
+	responseBody, err := c.get(ctx, trackerURL, parameters)
 	if err != nil {
 		return nil, err
 	}
+	return decodeResponse[TrackerList](responseBody)
+}
+
+// AllTrackers returns an iterator over trackers matching params, fetching
+// further pages as needed. The iteration stops at the first error.
+func (c *Client) AllTrackers(ctx context.Context, params TrackerListParams) iter.Seq2[*Tracker, error] {
+	return listAll[Tracker](ctx, c, trackerURL, "trackers", params.ListOptions, params.encode())
+}
 
-	tracker := &Tracker{}
-	if err := json.Unmarshal(responseBody, tracker); err != nil {
-		return nil, fmt.Errorf("error decode response: %s", err)
+// encode returns tracker specific parameters of the list.
+func (p TrackerListParams) encode() url.Values {
+	parameters := url.Values{}
+	if p.TrackingCode != "" {
+		parameters.Set("tracking_code", p.TrackingCode)
+	}
+	if p.Carrier != "" {
+		parameters.Set("carrier", p.Carrier.String())
 	}
-	return tracker, nil
+	return parameters
 }
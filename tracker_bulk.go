@@ -0,0 +1,121 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypost
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// trackerListBatchSize limits the number of tracking codes sent in a single
+// request, as all of them are passed in the query string.
+const trackerListBatchSize = 100
+
+// TrackerRequest is a tracking code to track, with optional carrier hint.
+type TrackerRequest struct {
+	TrackingCode string
+	Carrier      Carrier
+}
+
+// BulkTrackerResult reports which tracking codes EasyPost accepted for
+// tracking and which ones failed.
+type BulkTrackerResult struct {
+	Accepted []TrackerRequest
+	Failed   []TrackerRequestError
+}
+
+// TrackerRequestError is the failure of a single tracking code.
+type TrackerRequestError struct {
+	Request TrackerRequest
+	Err     error
+}
+
+func (e TrackerRequestError) Error() string {
+	return fmt.Sprintf("tracking code %s: %s", e.Request.TrackingCode, e.Err)
+}
+
+func (e TrackerRequestError) Unwrap() error {
+	return e.Err
+}
+
+// CreateTrackerList registers all tracking codes with EasyPost bulk tracker
+// creation. Trackers are created asynchronously, updates of them are sent
+// to web hooks. Requests are sent in batches and a failed batch doesn't stop
+// the following ones, but cancellation of ctx fails all remaining requests.
+// The returned error joins errors of failed batches and invalid requests,
+// the result is returned even if some of them failed.
+func (c *Client) CreateTrackerList(requests []TrackerRequest) (*BulkTrackerResult, error) {
+	return c.CreateTrackerListContext(context.Background(), requests)
+}
+
+func (c *Client) CreateTrackerListContext(ctx context.Context, requests []TrackerRequest) (*BulkTrackerResult, error) {
+	result := &BulkTrackerResult{}
+	var (
+		errs    []error
+		batches [][]TrackerRequest
+	)
+	for _, request := range requests {
+		if request.TrackingCode == "" {
+			failure := TrackerRequestError{Request: request, Err: errors.New("tracking code is empty")}
+			result.Failed = append(result.Failed, failure)
+			errs = append(errs, failure)
+			continue
+		}
+		if len(batches) == 0 || len(batches[len(batches)-1]) == trackerListBatchSize {
+			batches = append(batches, nil)
+		}
+		batches[len(batches)-1] = append(batches[len(batches)-1], request)
+	}
+
+	for i, batch := range batches {
+		if err := ctx.Err(); err != nil {
+			for _, batch := range batches[i:] {
+				result.fail(batch, err)
+			}
+			errs = append(errs, err)
+			break
+		}
+		if err := c.createTrackerBatch(ctx, batch); err != nil {
+			result.fail(batch, err)
+			errs = append(errs, fmt.Errorf("batch of %d tracking codes starting with %s: %w", len(batch), batch[0].TrackingCode, err))
+			continue
+		}
+		result.Accepted = append(result.Accepted, batch...)
+	}
+	return result, errors.Join(errs...)
+}
+
+func (r *BulkTrackerResult) fail(requests []TrackerRequest, err error) {
+	for _, request := range requests {
+		r.Failed = append(r.Failed, TrackerRequestError{Request: request, Err: err})
+	}
+}
+
+func (c *Client) createTrackerBatch(ctx context.Context, batch []TrackerRequest) error {
+	parameters := url.Values{}
+	for i, request := range batch {
+		parameters.Set(fmt.Sprintf("trackers[%d][tracking_code]", i), request.TrackingCode)
+		if request.Carrier != "" {
+			parameters.Set(fmt.Sprintf("trackers[%d][carrier]", i), request.Carrier.String())
+		}
+	}
+
+	// Creation of existing trackers returns them, so the request is safe
+	// to retry.
+	_, err := c.do(ctx, apiRequest{
+		method:     http.MethodPost,
+		path:       trackerURL + "/create_list",
+		parameters: parameters,
+		idempotent: true,
+	})
+	return err
+}
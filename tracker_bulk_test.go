@@ -0,0 +1,99 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypost
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestCreateTrackerList(t *testing.T) {
+	setup()
+
+	var requests []TrackerRequest
+	for i := 0; i < trackerListBatchSize+50; i++ {
+		requests = append(requests, TrackerRequest{TrackingCode: fmt.Sprintf("EZ%010d", i), Carrier: CarrierUSPS})
+	}
+	requests[trackerListBatchSize+10].TrackingCode = "INVALID"
+	requests = append(requests, TrackerRequest{Carrier: CarrierUPS})
+
+	result, err := testClient.CreateTrackerList(requests)
+	if err == nil {
+		t.Fatal("error expected")
+	}
+	var processingError ProcessingError
+	if !errors.As(err, &processingError) {
+		t.Fatalf("expected ProcessingError, got: %T(%s)", err, err)
+	}
+	if errs := err.(interface{ Unwrap() []error }).Unwrap(); len(errs) != 2 {
+		t.Fatalf("one error per failed batch and invalid request expected, got: %d", len(errs))
+	}
+
+	if !reflect.DeepEqual(result.Accepted, requests[:trackerListBatchSize]) {
+		t.Fatalf("unexpected accepted requests: %v", result.Accepted)
+	}
+	var failed []TrackerRequest
+	for _, failure := range result.Failed {
+		failed = append(failed, failure.Request)
+	}
+	expectedFailed := append([]TrackerRequest{{Carrier: CarrierUPS}}, requests[trackerListBatchSize:len(requests)-1]...)
+	if !reflect.DeepEqual(failed, expectedFailed) {
+		t.Fatalf("failed requests: \nexpected %v\n     got %v", expectedFailed, failed)
+	}
+
+	result, err = testClient.CreateTrackerList(requests[:10])
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(result.Accepted) != 10 || len(result.Failed) != 0 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestCreateTrackerListCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	batches := 0
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		batches++
+		w.Write([]byte("{}"))
+	}))
+	defer s.Close()
+	// The first batch succeeds and ctx is canceled right after it.
+	c := NewClient("", WithBaseURL(s.URL), WithMiddleware(func(next Handler) Handler {
+		return func(r *http.Request) (*Response, error) {
+			response, err := next(r)
+			cancel()
+			return response, err
+		}
+	}))
+
+	var requests []TrackerRequest
+	for i := 0; i < 3*trackerListBatchSize; i++ {
+		requests = append(requests, TrackerRequest{TrackingCode: fmt.Sprintf("EZ%010d", i)})
+	}
+	result, err := c.CreateTrackerListContext(ctx, requests)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("context canceled error expected, got: %v", err)
+	}
+	if batches != 1 {
+		t.Fatalf("unexpected number of batches, expected: 1, got: %d", batches)
+	}
+	if len(result.Accepted) != trackerListBatchSize || len(result.Failed) != 2*trackerListBatchSize {
+		t.Fatalf("unexpected result: %d accepted, %d failed", len(result.Accepted), len(result.Failed))
+	}
+	if errs := err.(interface{ Unwrap() []error }).Unwrap(); len(errs) != 1 {
+		t.Fatalf("single error expected, got: %d", len(errs))
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypost
+
+import (
+	"context"
+	"sync"
+)
+
+// defaultFetchWorkers is the number of concurrent requests of FetchTrackers
+// if the number isn't set.
+const defaultFetchWorkers = 4
+
+// TrackerResult is the outcome of fetching a single tracker.
+type TrackerResult struct {
+	Request TrackerRequest
+	Tracker *Tracker
+	Err     error
+}
+
+// FetchTrackers creates trackers of all requests with at most workers
+// concurrent calls of CreateTrackerContext, so they share retry policy of the
+// client. Results are sent to the returned channel in the order of
+// completion, one for each request, and the channel is closed after the last
+// one. Once ctx is done, the remaining requests fail with its error. The
+// channel is buffered to hold all results, so workers exit even if the caller
+// stops receiving.
+func (c *Client) FetchTrackers(ctx context.Context, requests []TrackerRequest, workers int) <-chan TrackerResult {
+	if workers <= 0 {
+		workers = defaultFetchWorkers
+	}
+	workers = min(workers, len(requests))
+
+	pending := make(chan TrackerRequest, len(requests))
+	for _, request := range requests {
+		pending <- request
+	}
+	close(pending)
+
+	results := make(chan TrackerResult, len(requests))
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for request := range pending {
+				if err := ctx.Err(); err != nil {
+					results <- TrackerResult{Request: request, Err: err}
+					continue
+				}
+				tracker, err := c.CreateTrackerContext(ctx, request.TrackingCode, request.Carrier)
+				results <- TrackerResult{Request: request, Tracker: tracker, Err: err}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+	return results
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypost
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestFetchTrackers(t *testing.T) {
+	var (
+		mu        sync.Mutex
+		active    int
+		maxActive int
+	)
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		active++
+		maxActive = max(maxActive, active)
+		mu.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+
+		mu.Lock()
+		active--
+		mu.Unlock()
+		if r.FormValue("tracker[tracking_code]") == TestTrackerCodes[0] {
+			w.WriteHeader(http.StatusPaymentRequired)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"object": "Tracker", "tracking_code": "` + r.FormValue("tracker[tracking_code]") + `"}`))
+	}))
+	defer s.Close()
+	c := NewClient("", WithBaseURL(s.URL))
+
+	var requests []TrackerRequest
+	for _, trackingCode := range TestTrackerCodes {
+		requests = append(requests, TrackerRequest{TrackingCode: trackingCode})
+	}
+
+	results := map[string]TrackerResult{}
+	for result := range c.FetchTrackers(context.Background(), requests, 2) {
+		results[result.Request.TrackingCode] = result
+	}
+	if len(results) != len(requests) {
+		t.Fatalf("unexpected number of results, expected: %d, got: %d", len(requests), len(results))
+	}
+	if maxActive > 2 {
+		t.Fatalf("too many concurrent requests: %d", maxActive)
+	}
+	for trackingCode, result := range results {
+		if trackingCode == TestTrackerCodes[0] {
+			if _, ok := result.Err.(PaymentRequiredError); !ok {
+				t.Errorf("payment error expected: %T (%s)", result.Err, result.Err)
+			}
+			continue
+		}
+		if result.Err != nil {
+			t.Errorf("unexpected error: %s", result.Err)
+			continue
+		}
+		if result.Tracker.TrackingCode != trackingCode {
+			t.Errorf("unexpected tracker, expected: %s, got: %s", trackingCode, result.Tracker.TrackingCode)
+		}
+	}
+}
+
+func TestFetchTrackersCanceled(t *testing.T) {
+	setup()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var requests []TrackerRequest
+	for _, trackingCode := range TestTrackerCodes {
+		requests = append(requests, TrackerRequest{TrackingCode: trackingCode})
+	}
+	n := 0
+	for result := range testClient.FetchTrackers(ctx, requests, 3) {
+		if !errors.Is(result.Err, context.Canceled) {
+			t.Errorf("context canceled error expected, got: %v", result.Err)
+		}
+		n++
+	}
+	if n != len(requests) {
+		t.Fatalf("unexpected number of results, expected: %d, got: %d", len(requests), n)
+	}
+}
@@ -9,7 +9,10 @@
 package easypost
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"net/http"
 	"reflect"
 	"testing"
 	"time"
@@ -52,8 +55,13 @@ func TestCreateTracker(t *testing.T) {
 		details: b,
 	}
 	_, err = testClient.GetTracker("EZ3000000002", "")
-	if !reflect.DeepEqual(expectedError, err) {
-		t.Fatalf("error:\nexpected: %v \ngot: %v", expectedError, err)
+	processingError, ok := err.(ProcessingError)
+	if !ok || processingError.StatusCode != http.StatusUnprocessableEntity {
+		t.Fatalf("processing error expected: %T (%s)", err, err)
+	}
+	processingError.ResponseInfo = ResponseInfo{}
+	if !reflect.DeepEqual(expectedError, processingError) {
+		t.Fatalf("error:\nexpected: %v \ngot: %v", expectedError, processingError)
 	}
 
 	_, err = testClient.GetTracker(paymentError.Error(), "")
@@ -80,3 +88,93 @@ func TestJSONCarrierDetails(t *testing.T) {
 		t.Errorf("unexpected time, expected: 2022-12-08 20:11:53, got: %s", c.EstimatedDeliveryTime())
 	}
 }
+
+func TestCreateTrackerContextCanceled(t *testing.T) {
+	setup()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := testClient.CreateTrackerContext(ctx, "EZ3000000003", "")
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("context canceled error expected, got: %T (%s)", err, err)
+	}
+}
+
+func TestRetrieveTracker(t *testing.T) {
+	setup()
+
+	tracker, err := testClient.RetrieveTracker("trk_3")
+	if err != nil {
+		t.Fatalf("not success response: %s", err)
+	}
+	if tracker.TrackingCode != TestTrackerCodes[2] {
+		t.Fatalf("unexpected tracking code, expected: %s, got: %s", TestTrackerCodes[2], tracker.TrackingCode)
+	}
+
+	if _, err := testClient.RetrieveTracker("trk_0"); err == nil {
+		t.Fatal("error expected")
+	}
+}
+
+func TestListTrackers(t *testing.T) {
+	setup()
+
+	trackerIDs := func(trackers []Tracker) []string {
+		var ids []string
+		for _, tracker := range trackers {
+			ids = append(ids, tracker.ID)
+		}
+		return ids
+	}
+
+	for _, test := range []struct {
+		name            string
+		params          TrackerListParams
+		expectedIDs     []string
+		expectedHasMore bool
+	}{
+		{
+			name:        "all",
+			params:      TrackerListParams{},
+			expectedIDs: []string{"trk_7", "trk_6", "trk_5", "trk_4", "trk_3", "trk_2", "trk_1"},
+		},
+		{
+			name:            "page",
+			params:          TrackerListParams{ListOptions: ListOptions{PageSize: 2, BeforeID: "trk_6"}},
+			expectedIDs:     []string{"trk_5", "trk_4"},
+			expectedHasMore: true,
+		},
+		{
+			name: "created at",
+			params: TrackerListParams{ListOptions: ListOptions{
+				StartDateTime: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+				EndDateTime:   time.Date(2026, 1, 4, 0, 0, 0, 0, time.UTC),
+			}},
+			expectedIDs: []string{"trk_3", "trk_2"},
+		},
+		{
+			name:        "carrier",
+			params:      TrackerListParams{Carrier: "UPS"},
+			expectedIDs: []string{"trk_6", "trk_4", "trk_2"},
+		},
+		{
+			name:        "tracking code",
+			params:      TrackerListParams{TrackingCode: TestTrackerCodes[0]},
+			expectedIDs: []string{"trk_1"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			list, err := testClient.ListTrackers(test.params)
+			if err != nil {
+				t.Fatalf("not success response: %s", err)
+			}
+			if ids := trackerIDs(list.Trackers); !reflect.DeepEqual(ids, test.expectedIDs) {
+				t.Fatalf("trackers: \nexpected %v\n     got %v", test.expectedIDs, ids)
+			}
+			if list.HasMore != test.expectedHasMore {
+				t.Fatalf("unexpected has more: %t", list.HasMore)
+			}
+		})
+	}
+}
@@ -9,9 +9,15 @@
 package easypost
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -54,6 +60,14 @@ func (e Event) GetResult() (interface{}, error) {
 		result = &Tracker{}
 	case RecordTypeAddress:
 		result = &Address{}
+	case RecordTypeShipment:
+		result = &Shipment{}
+	case RecordTypeCustomsInfo:
+		result = &CustomsInfo{}
+	case RecordTypeCustomsItem:
+		result = &CustomsItem{}
+	case RecordTypeRefund:
+		result = &Refund{}
 	default:
 		return nil, NotSupportedRecordError{record.Object}
 	}
@@ -66,21 +80,103 @@ func (e Event) GetResult() (interface{}, error) {
 
 type WebHookHandler func(r *http.Request) (*Event, error)
 
-func NewWebHookHandler(apiKey, keySecret string) WebHookHandler {
+const (
+	hmacSignatureHeader = "X-Hmac-Signature"
+	hmacSignaturePrefix = "hmac-sha256-hex="
+
+	defaultMaxWebHookBodySize = 5 << 20
+)
+
+// WebHookOption configures a WebHookHandler created by NewWebHookHandler.
+type WebHookOption func(*webHookOptions)
+
+type webHookOptions struct {
+	verifySignature bool
+	hmacSecrets     [][]byte
+	maxBodySize     int64
+}
+
+// WithHMACSecrets makes the handler verify the HMAC-SHA256 signature of the
+// request body in X-Hmac-Signature header, which EasyPost sends when the
+// webhook has a secret. A signature made with any of secrets is accepted,
+// so the secret can be rotated without downtime. Empty secrets are ignored,
+// if none of secrets is set all requests are rejected.
+func WithHMACSecrets(secrets ...string) WebHookOption {
+	return func(o *webHookOptions) {
+		o.verifySignature = true
+		for _, secret := range secrets {
+			if secret != "" {
+				o.hmacSecrets = append(o.hmacSecrets, []byte(secret))
+			}
+		}
+	}
+}
+
+// WithMaxBodySize limits the size of the request body, 5MiB by default.
+func WithMaxBodySize(n int64) WebHookOption {
+	return func(o *webHookOptions) {
+		o.maxBodySize = n
+	}
+}
+
+func NewWebHookHandler(apiKey, keySecret string, options ...WebHookOption) WebHookHandler {
+	o := webHookOptions{maxBodySize: defaultMaxWebHookBodySize}
+	for _, option := range options {
+		option(&o)
+	}
+
 	return func(r *http.Request) (*Event, error) {
 		username, password, ok := r.BasicAuth()
 		if ok {
-			if username != apiKey || password != keySecret {
+			// Both comparisons are made so the time doesn't tell which one failed.
+			usernameMatch := subtle.ConstantTimeCompare([]byte(username), []byte(apiKey))
+			passwordMatch := subtle.ConstantTimeCompare([]byte(password), []byte(keySecret))
+			if usernameMatch&passwordMatch != 1 {
 				return nil, unauthorizedError
 			}
 		} else if apiKey != "" {
 			return nil, unauthorizedError
 		}
 
+		body, err := io.ReadAll(http.MaxBytesReader(nil, r.Body, o.maxBodySize))
+		if err != nil {
+			return nil, fmt.Errorf("error reading easypost response: %w", err)
+		}
+		if o.verifySignature {
+			if err := verifySignature(r.Header.Get(hmacSignatureHeader), body, o.hmacSecrets); err != nil {
+				return nil, err
+			}
+		}
+
 		event := Event{}
-		if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+		if err := json.Unmarshal(body, &event); err != nil {
 			return nil, fmt.Errorf("error reading easypost response: %s", err)
 		}
 		return &event, nil
 	}
 }
+
+func verifySignature(header string, body []byte, secrets [][]byte) error {
+	if len(secrets) == 0 {
+		return SignatureError{Reason: "no webhook secret configured"}
+	}
+	if header == "" {
+		return SignatureError{Reason: "missing " + hmacSignatureHeader + " header"}
+	}
+	hexSignature, ok := strings.CutPrefix(header, hmacSignaturePrefix)
+	if !ok {
+		return SignatureError{Reason: "unsupported signature scheme"}
+	}
+	signature, err := hex.DecodeString(hexSignature)
+	if err != nil {
+		return SignatureError{Reason: "malformed signature"}
+	}
+	for _, secret := range secrets {
+		mac := hmac.New(sha256.New, secret)
+		mac.Write(body)
+		if hmac.Equal(signature, mac.Sum(nil)) {
+			return nil
+		}
+	}
+	return SignatureError{Reason: "signature mismatch"}
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypost
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// Webhook is an endpoint EasyPost delivers events to. DisabledAt is set when
+// EasyPost stopped delivering events because of repeated failures.
+type Webhook struct {
+	ID         string     `json:"id"`
+	Object     RecordType `json:"object"`
+	Mode       string     `json:"mode"`
+	URL        string     `json:"url"`
+	DisabledAt *DateTime  `json:"disabled_at"`
+}
+
+// WebhookParams are parameters of a created webhook, empty fields aren't
+// sent.
+type WebhookParams struct {
+	URL string
+	// WebhookSecret makes EasyPost sign events with HMAC, see
+	// WithHMACSecrets.
+	WebhookSecret string
+}
+
+func (p WebhookParams) encode() url.Values {
+	parameters := url.Values{}
+	if p.URL != "" {
+		parameters.Set("webhook[url]", p.URL)
+	}
+	if p.WebhookSecret != "" {
+		parameters.Set("webhook[webhook_secret]", p.WebhookSecret)
+	}
+	return parameters
+}
+
+// WebhookUpdateParams are parameters of an updated webhook, empty fields
+// aren't sent. The URL of a webhook can't be changed, a webhook with another
+// URL has to be created instead.
+type WebhookUpdateParams struct {
+	// WebhookSecret replaces the secret EasyPost signs events with.
+	WebhookSecret string
+}
+
+func (p WebhookUpdateParams) encode() url.Values {
+	return WebhookParams{WebhookSecret: p.WebhookSecret}.encode()
+}
+
+// CreateWebhook registers params.URL to receive events.
+func (c *Client) CreateWebhook(params WebhookParams) (*Webhook, error) {
+	return c.CreateWebhookContext(context.Background(), params)
+}
+
+func (c *Client) CreateWebhookContext(ctx context.Context, params WebhookParams) (*Webhook, error) {
+	responseBody, err := c.post(ctx, webhookURL, params.encode())
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[Webhook](responseBody)
+}
+
+// ListWebhooks returns all webhooks of the mode of the API key.
+func (c *Client) ListWebhooks() ([]Webhook, error) {
+	return c.ListWebhooksContext(context.Background())
+}
+
+func (c *Client) ListWebhooksContext(ctx context.Context) ([]Webhook, error) {
+	responseBody, err := c.get(ctx, webhookURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	list, err := decodeResponse[struct {
+		Webhooks []Webhook `json:"webhooks"`
+	}](responseBody)
+	if err != nil {
+		return nil, err
+	}
+	return list.Webhooks, nil
+}
+
+func (c *Client) GetWebhook(id string) (*Webhook, error) {
+	return c.GetWebhookContext(context.Background(), id)
+}
+
+func (c *Client) GetWebhookContext(ctx context.Context, id string) (*Webhook, error) {
+	responseBody, err := c.get(ctx, objectPath(webhookURL, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[Webhook](responseBody)
+}
+
+// UpdateWebhook re-enables a disabled webhook, and changes its secret when
+// params.WebhookSecret is set.
+func (c *Client) UpdateWebhook(id string, params WebhookUpdateParams) (*Webhook, error) {
+	return c.UpdateWebhookContext(context.Background(), id, params)
+}
+
+func (c *Client) UpdateWebhookContext(ctx context.Context, id string, params WebhookUpdateParams) (*Webhook, error) {
+	responseBody, err := c.do(ctx, apiRequest{
+		method:     http.MethodPatch,
+		path:       objectPath(webhookURL, id),
+		parameters: params.encode(),
+		idempotent: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[Webhook](responseBody)
+}
+
+func (c *Client) DeleteWebhook(id string) error {
+	return c.DeleteWebhookContext(context.Background(), id)
+}
+
+func (c *Client) DeleteWebhookContext(ctx context.Context, id string) error {
+	_, err := c.do(ctx, apiRequest{
+		method:     http.MethodDelete,
+		path:       objectPath(webhookURL, id),
+		idempotent: true,
+	})
+	return err
+}
@@ -0,0 +1,66 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypost
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWebhooks(t *testing.T) {
+	setup()
+
+	webhook, err := testClient.CreateWebhook(WebhookParams{URL: "https://example.com/webhook", WebhookSecret: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if webhook.ID != "hook_1" || webhook.URL != "https://example.com/webhook" || webhook.Object != RecordTypeWebhook {
+		t.Fatalf("unexpected webhook: %+v", webhook)
+	}
+	if _, err := testClient.CreateWebhook(WebhookParams{}); !errors.Is(err, ErrorCode("WEBHOOK.INVALID_PARAMS")) {
+		t.Fatalf("invalid params error expected, got: %v", err)
+	}
+
+	testWebhooks[0].DisabledAt = &DateTime{Time: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)}
+	webhook, err = testClient.GetWebhook("hook_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if webhook.DisabledAt == nil {
+		t.Fatalf("disabled webhook expected: %+v", webhook)
+	}
+
+	webhook, err = testClient.UpdateWebhook("hook_1", WebhookUpdateParams{WebhookSecret: "new"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if webhook.DisabledAt != nil || webhook.URL != "https://example.com/webhook" {
+		t.Fatalf("enabled webhook expected: %+v", webhook)
+	}
+
+	webhooks, err := testClient.ListWebhooks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(webhooks) != 1 || webhooks[0].ID != "hook_1" {
+		t.Fatalf("unexpected webhooks: %+v", webhooks)
+	}
+
+	if err := testClient.DeleteWebhook("hook_1"); err != nil {
+		t.Fatal(err)
+	}
+	var notFoundError NotFoundError
+	if err := testClient.DeleteWebhook("hook_1"); !errors.As(err, &notFoundError) {
+		t.Fatalf("not found error expected, got: %v", err)
+	}
+	if webhooks, err := testClient.ListWebhooks(); err != nil || len(webhooks) != 0 {
+		t.Fatalf("no webhooks expected, got: %+v, %v", webhooks, err)
+	}
+}
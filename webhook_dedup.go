@@ -0,0 +1,292 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypost
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// EventStore keeps IDs of processed events, so a WebHookMux processes every
+// event at most once when EasyPost delivers it again. Implementations have
+// to be safe for concurrent use.
+type EventStore interface {
+	// Claim marks the event as processed. It reports false if the event was
+	// already claimed and the claim wasn't released.
+	Claim(ctx context.Context, eventID string) (bool, error)
+	// Release drops the claim of the event which failed to be processed, so
+	// its next delivery is processed again.
+	Release(ctx context.Context, eventID string) error
+}
+
+// Deduplicate makes the mux skip events claimed in store. An event is
+// claimed before it is dispatched and released if dispatching fails. Events
+// without ID are always dispatched.
+func (m *WebHookMux) Deduplicate(store EventStore) {
+	m.store = store
+}
+
+// MemoryEventStore is an EventStore keeping event IDs in memory for TTL.
+type MemoryEventStore struct {
+	ttl time.Duration
+
+	mu        sync.Mutex
+	claims    map[string]time.Time
+	nextSweep time.Time
+}
+
+// NewMemoryEventStore returns a MemoryEventStore which forgets events after
+// ttl, it should be longer than the time EasyPost retries deliveries.
+func NewMemoryEventStore(ttl time.Duration) *MemoryEventStore {
+	return &MemoryEventStore{
+		ttl:    ttl,
+		claims: map[string]time.Time{},
+	}
+}
+
+func (s *MemoryEventStore) Claim(ctx context.Context, eventID string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.claim(eventID, time.Now()), nil
+}
+
+func (s *MemoryEventStore) claim(eventID string, now time.Time) bool {
+	if now.After(s.nextSweep) {
+		for id, expiresAt := range s.claims {
+			if !now.Before(expiresAt) {
+				delete(s.claims, id)
+			}
+		}
+		s.nextSweep = now.Add(s.ttl)
+	}
+	if expiresAt, ok := s.claims[eventID]; ok && now.Before(expiresAt) {
+		return false
+	}
+	s.claims[eventID] = now.Add(s.ttl)
+	return true
+}
+
+func (s *MemoryEventStore) Release(ctx context.Context, eventID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.claims, eventID)
+	return nil
+}
+
+// minCompactedLines is the size of the journal of FileEventStore below
+// which it isn't compacted.
+const minCompactedLines = 1024
+
+// FileEventStore is an EventStore which keeps event IDs in a journal file,
+// so they survive restarts. The journal is compacted when it is opened and
+// when it grows to twice the number of live claims. The file can't be
+// shared by several processes.
+type FileEventStore struct {
+	memory *MemoryEventStore
+	path   string
+
+	mu    sync.Mutex
+	file  *os.File
+	lines int
+	// broken is set when an append fails, the journal may end with a
+	// partial line then, so it is compacted before the next append.
+	broken bool
+}
+
+// NewFileEventStore opens or creates the file at path and loads the events
+// claimed within ttl from it.
+func NewFileEventStore(path string, ttl time.Duration) (*FileEventStore, error) {
+	s := &FileEventStore{
+		memory: NewMemoryEventStore(ttl),
+		path:   path,
+	}
+	if err := loadEventClaims(path, s.memory.claims); err != nil {
+		return nil, err
+	}
+	if err := s.compact(time.Now()); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// compact writes the live claims to a new journal and replaces the old one
+// with it. The caller must hold the locks of s and its memory store.
+func (s *FileEventStore) compact(now time.Time) error {
+	tmp := s.path + ".tmp"
+	file, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return fmt.Errorf("error create event store: %w", err)
+	}
+	w := bufio.NewWriter(file)
+	lines := 0
+	for id, expiresAt := range s.memory.claims {
+		if !now.Before(expiresAt) {
+			delete(s.memory.claims, id)
+			continue
+		}
+		w.WriteString(claimLine(id, expiresAt))
+		lines++
+	}
+	err = w.Flush()
+	if err == nil {
+		err = file.Sync()
+	}
+	if err == nil {
+		err = os.Rename(tmp, s.path)
+	}
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("error write event store: %w", err)
+	}
+
+	if s.file != nil {
+		s.file.Close()
+	}
+	s.file = file
+	s.lines = lines
+	s.broken = false
+	return nil
+}
+
+// Journal lines are "+ <quoted id> <unix expiry>" for claims and
+// "- <quoted id>" for releases. IDs are quoted, as they come from requests.
+func claimLine(eventID string, expiresAt time.Time) string {
+	return fmt.Sprintf("+ %s %d\n", strconv.Quote(eventID), expiresAt.Unix())
+}
+
+func releaseLine(eventID string) string {
+	return fmt.Sprintf("- %s\n", strconv.Quote(eventID))
+}
+
+// loadEventClaims reads the journal at path into claims.
+func loadEventClaims(path string, claims map[string]time.Time) error {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error open event store: %w", err)
+	}
+	defer file.Close()
+
+	// A crash while appending may leave the last line unterminated or
+	// garbled, that claim or release was never reported as done, so the
+	// line is skipped and dropped by the compaction on open. Unparsable
+	// lines elsewhere are corruption.
+	r := bufio.NewReader(file)
+	var lineErr error
+	for {
+		line, err := r.ReadString('\n')
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("error read event store: %w", err)
+		}
+		if lineErr != nil {
+			return lineErr
+		}
+		line = strings.TrimSuffix(line, "\n")
+		if line == "" {
+			continue
+		}
+		if err := parseJournalLine(line, claims); err != nil {
+			lineErr = fmt.Errorf("error parse event store line %q: %w", line, err)
+		}
+	}
+}
+
+func parseJournalLine(line string, claims map[string]time.Time) error {
+	switch {
+	case strings.HasPrefix(line, "+ "):
+		i := strings.LastIndexByte(line, ' ')
+		if i < 2 {
+			return errors.New("missing expiry")
+		}
+		expiresAt, err := strconv.ParseInt(line[i+1:], 10, 64)
+		if err != nil {
+			return err
+		}
+		id, err := strconv.Unquote(line[2:i])
+		if err != nil {
+			return err
+		}
+		claims[id] = time.Unix(expiresAt, 0)
+	case strings.HasPrefix(line, "- "):
+		id, err := strconv.Unquote(line[2:])
+		if err != nil {
+			return err
+		}
+		delete(claims, id)
+	default:
+		return errors.New("unexpected operation")
+	}
+	return nil
+}
+
+func (s *FileEventStore) Claim(ctx context.Context, eventID string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.memory.mu.Lock()
+	defer s.memory.mu.Unlock()
+
+	now := time.Now()
+	if !s.memory.claim(eventID, now) {
+		return false, nil
+	}
+	if err := s.append(now, claimLine(eventID, now.Add(s.memory.ttl))); err != nil {
+		delete(s.memory.claims, eventID)
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *FileEventStore) Release(ctx context.Context, eventID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.memory.mu.Lock()
+	defer s.memory.mu.Unlock()
+
+	delete(s.memory.claims, eventID)
+	return s.append(time.Now(), releaseLine(eventID))
+}
+
+// append writes line to the journal, compacting it first if it has grown.
+func (s *FileEventStore) append(now time.Time, line string) error {
+	if s.broken || (s.lines >= minCompactedLines && s.lines >= 2*len(s.memory.claims)) {
+		if err := s.compact(now); err != nil {
+			return err
+		}
+	}
+	if _, err := s.file.WriteString(line); err != nil {
+		s.broken = true
+		return fmt.Errorf("error write event store: %w", err)
+	}
+	if err := s.file.Sync(); err != nil {
+		s.broken = true
+		return fmt.Errorf("error write event store: %w", err)
+	}
+	s.lines++
+	return nil
+}
+
+// Close closes the file of the store.
+func (s *FileEventStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
@@ -0,0 +1,264 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypost
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMemoryEventStore(t *testing.T) {
+	s := NewMemoryEventStore(time.Minute)
+	now := time.Now()
+
+	if !s.claim("evt_1", now) {
+		t.Fatal("first claim expected to succeed")
+	}
+	if s.claim("evt_1", now.Add(59*time.Second)) {
+		t.Fatal("claim of claimed event expected to fail")
+	}
+	if !s.claim("evt_1", now.Add(time.Minute)) {
+		t.Fatal("claim of expired event expected to succeed")
+	}
+	if err := s.Release(context.Background(), "evt_1"); err != nil {
+		t.Fatal(err)
+	}
+	if ok, _ := s.Claim(context.Background(), "evt_1"); !ok {
+		t.Fatal("claim of released event expected to succeed")
+	}
+}
+
+func TestEventStoreConcurrentClaims(t *testing.T) {
+	fileStore, err := NewFileEventStore(filepath.Join(t.TempDir(), "events"), time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fileStore.Close()
+
+	for name, store := range map[string]EventStore{
+		"memory": NewMemoryEventStore(time.Hour),
+		"file":   fileStore,
+	} {
+		t.Run(name, func(t *testing.T) {
+			var (
+				wg      sync.WaitGroup
+				claimed atomic.Int32
+			)
+			for i := 0; i < 50; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					ok, err := store.Claim(context.Background(), "evt_1")
+					if err != nil {
+						t.Error(err)
+					}
+					if ok {
+						claimed.Add(1)
+					}
+				}()
+			}
+			wg.Wait()
+			if claimed.Load() != 1 {
+				t.Fatalf("unexpected number of claims, expected: 1, got: %d", claimed.Load())
+			}
+		})
+	}
+}
+
+func TestFileEventStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "events")
+	s, err := NewFileEventStore(path, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []string{"evt_1", "evt_2"} {
+		if ok, err := s.Claim(ctx, id); !ok || err != nil {
+			t.Fatalf("claim of %s expected to succeed: %v", id, err)
+		}
+	}
+	if err := s.Release(ctx, "evt_2"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err = NewFileEventStore(path, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	if ok, err := s.Claim(ctx, "evt_1"); ok || err != nil {
+		t.Fatalf("claim of evt_1 expected to fail after reopen: %v", err)
+	}
+	if ok, err := s.Claim(ctx, "evt_2"); !ok || err != nil {
+		t.Fatalf("claim of released evt_2 expected to succeed after reopen: %v", err)
+	}
+}
+
+func TestFileEventStoreQuotesIDs(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "events")
+	ids := []string{"evt 1", "evt\n2", `evt"3`, "evt_4"}
+	s, err := NewFileEventStore(path, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range ids {
+		if ok, err := s.Claim(ctx, id); !ok || err != nil {
+			t.Fatalf("claim of %q expected to succeed: %v", id, err)
+		}
+	}
+	if err := s.Release(ctx, "evt_4"); err != nil {
+		t.Fatal(err)
+	}
+	s.Close()
+
+	s, err = NewFileEventStore(path, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	for i, id := range ids {
+		expected := i < 3
+		if ok, err := s.Claim(ctx, id); ok == expected || err != nil {
+			t.Fatalf("unexpected claim of %q after reopen: %v %v", id, ok, err)
+		}
+	}
+}
+
+func TestFileEventStoreCompaction(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "events")
+	s, err := NewFileEventStore(path, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	// Every failed event adds two lines without leaving a live claim.
+	for i := 0; i < 2*minCompactedLines; i++ {
+		id := fmt.Sprintf("evt_%d", i)
+		if _, err := s.Claim(ctx, id); err != nil {
+			t.Fatal(err)
+		}
+		if err := s.Release(ctx, id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := s.Claim(ctx, "evt_live"); err != nil {
+		t.Fatal(err)
+	}
+	if s.lines > minCompactedLines+1 {
+		t.Fatalf("journal isn't compacted: %d lines", s.lines)
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lines := bytes.Count(b, []byte("\n")); lines != s.lines {
+		t.Fatalf("unexpected number of lines in the file, expected: %d, got: %d", s.lines, lines)
+	}
+
+	reopened, err := NewFileEventStore(path, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reopened.Close()
+	if ok, _ := reopened.Claim(ctx, "evt_live"); ok {
+		t.Fatal("live claim lost by compaction")
+	}
+}
+
+func TestFileEventStoreTruncatedLine(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "events")
+	s, err := NewFileEventStore(path, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := s.Claim(ctx, "evt_1"); !ok || err != nil {
+		t.Fatalf("claim expected to succeed: %v", err)
+	}
+	s.Close()
+
+	for _, tail := range []string{`+ "evt_`, "+ \"evt_2\" garbled\n"} {
+		file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		file.WriteString(tail)
+		file.Close()
+
+		s, err = NewFileEventStore(path, time.Hour)
+		if err != nil {
+			t.Fatalf("%q: %v", tail, err)
+		}
+		if ok, err := s.Claim(ctx, "evt_1"); ok || err != nil {
+			t.Fatalf("%q: claim is lost: %v", tail, err)
+		}
+		if ok, err := s.Claim(ctx, "evt_2"); !ok || err != nil {
+			t.Fatalf("%q: claim of evt_2 expected to succeed: %v", tail, err)
+		}
+		if err := s.Release(ctx, "evt_2"); err != nil {
+			t.Fatal(err)
+		}
+		s.Close()
+	}
+
+	// Corruption followed by other lines isn't recovered from.
+	if err := os.WriteFile(path, []byte("+ \"evt_1\" garbled\n- \"evt_1\"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewFileEventStore(path, time.Hour); err == nil {
+		t.Fatal("error expected")
+	}
+}
+
+func TestWebHookMuxDeduplicate(t *testing.T) {
+	calls := 0
+	fail := true
+	m := NewWebHookMux(NewWebHookHandler("", ""))
+	m.OnTrackerUpdated(func(ctx context.Context, e *Event, tracker *Tracker) error {
+		calls++
+		if fail {
+			fail = false
+			return errors.New("failing")
+		}
+		return nil
+	})
+	m.Deduplicate(NewMemoryEventStore(time.Hour))
+
+	body := newTestEvent(t, EventTrackerUpdated, "test/trackers/EZ3000000003.json")
+	for i, expected := range []struct {
+		status int
+		calls  int
+	}{
+		{status: http.StatusInternalServerError, calls: 1},
+		{status: http.StatusOK, calls: 2},
+		{status: http.StatusOK, calls: 2},
+	} {
+		w := httptest.NewRecorder()
+		m.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body)))
+		if w.Code != expected.status || calls != expected.calls {
+			t.Fatalf("delivery %d: unexpected status %d and calls %d", i+1, w.Code, calls)
+		}
+	}
+}
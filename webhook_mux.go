@@ -0,0 +1,184 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypost
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+const (
+	EventTrackerCreated = "tracker.created"
+	EventTrackerUpdated = "tracker.updated"
+)
+
+// WebHookMux is an http.Handler which receives events with a WebHookHandler
+// and dispatches them to the functions registered for their type. It answers
+// 401 to requests failing authentication or signature verification, 400 to
+// malformed events, 500 when the function fails, so EasyPost retries the
+// delivery, and 200 otherwise. Functions have to be registered before the
+// mux serves requests.
+type WebHookMux struct {
+	receive  WebHookHandler
+	routes   []webHookRoute
+	fallback func(ctx context.Context, e *Event) error
+	onError  func(ctx context.Context, e *Event, err error)
+	store    EventStore
+}
+
+type webHookRoute struct {
+	// description is the description of matching events, empty matches
+	// events of any description.
+	description string
+	// handle reports false if the result isn't of the route type.
+	handle func(ctx context.Context, e *Event, result interface{}) (bool, error)
+}
+
+// NewWebHookMux returns a WebHookMux receiving events with handler.
+func NewWebHookMux(handler WebHookHandler) *WebHookMux {
+	return &WebHookMux{receive: handler}
+}
+
+func onResult[T any](m *WebHookMux, description string, f func(ctx context.Context, e *Event, result *T) error) {
+	m.routes = append(m.routes, webHookRoute{
+		description: description,
+		handle: func(ctx context.Context, e *Event, result interface{}) (bool, error) {
+			v, ok := result.(*T)
+			if !ok {
+				return false, nil
+			}
+			return true, f(ctx, e, v)
+		},
+	})
+}
+
+// OnTrackerCreated registers f for tracker.created events.
+func (m *WebHookMux) OnTrackerCreated(f func(ctx context.Context, e *Event, t *Tracker) error) {
+	onResult(m, EventTrackerCreated, f)
+}
+
+// OnTrackerUpdated registers f for tracker.updated events.
+func (m *WebHookMux) OnTrackerUpdated(f func(ctx context.Context, e *Event, t *Tracker) error) {
+	onResult(m, EventTrackerUpdated, f)
+}
+
+// OnAddress registers f for events with an Address result.
+func (m *WebHookMux) OnAddress(f func(ctx context.Context, e *Event, a *Address) error) {
+	onResult(m, "", f)
+}
+
+// OnShipment registers f for events with a Shipment result.
+func (m *WebHookMux) OnShipment(f func(ctx context.Context, e *Event, s *Shipment) error) {
+	onResult(m, "", f)
+}
+
+// OnRefund registers f for events with a Refund result.
+func (m *WebHookMux) OnRefund(f func(ctx context.Context, e *Event, r *Refund) error) {
+	onResult(m, "", f)
+}
+
+// Fallback registers f for events which no other function is registered
+// for, including events with results of unsupported types. Such events are
+// acknowledged and dropped without a fallback.
+func (m *WebHookMux) Fallback(f func(ctx context.Context, e *Event) error) {
+	m.fallback = f
+}
+
+// OnError registers f to be notified about failed deliveries, e is nil if
+// the event wasn't received. Error details aren't sent in responses.
+func (m *WebHookMux) OnError(f func(ctx context.Context, e *Event, err error)) {
+	m.onError = f
+}
+
+func (m *WebHookMux) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	event, err := m.receive(r)
+	status := http.StatusOK
+	if err != nil {
+		var (
+			unauthorized UnauthorizedError
+			signature    SignatureError
+		)
+		status = http.StatusBadRequest
+		if errors.As(err, &unauthorized) || errors.As(err, &signature) {
+			status = http.StatusUnauthorized
+		}
+	} else {
+		status, err = m.dispatch(r.Context(), event)
+	}
+
+	if err != nil {
+		if m.onError != nil {
+			m.onError(r.Context(), event, err)
+		}
+		http.Error(w, http.StatusText(status), status)
+		return
+	}
+	w.WriteHeader(status)
+}
+
+// Dispatch calls the function registered for e, as if e was delivered to
+// the mux.
+func (m *WebHookMux) Dispatch(ctx context.Context, e *Event) error {
+	_, err := m.dispatch(ctx, e)
+	return err
+}
+
+// dispatch returns the response status for e along with the error.
+func (m *WebHookMux) dispatch(ctx context.Context, e *Event) (int, error) {
+	if m.store == nil || e.ID == "" {
+		return m.route(ctx, e)
+	}
+
+	claimed, err := m.store.Claim(ctx, e.ID)
+	if err != nil {
+		return http.StatusInternalServerError, fmt.Errorf("error claim event %s: %w", e.ID, err)
+	}
+	if !claimed {
+		return http.StatusOK, nil
+	}
+	status, err := m.route(ctx, e)
+	if err != nil {
+		if releaseErr := m.store.Release(ctx, e.ID); releaseErr != nil {
+			err = errors.Join(err, fmt.Errorf("error release event %s: %w", e.ID, releaseErr))
+		}
+	}
+	return status, err
+}
+
+// route calls the function registered for e.
+func (m *WebHookMux) route(ctx context.Context, e *Event) (int, error) {
+	result, err := e.GetResult()
+	var notSupported NotSupportedRecordError
+	if err != nil && !errors.As(err, &notSupported) {
+		return http.StatusBadRequest, err
+	}
+
+	if err == nil {
+		for _, route := range m.routes {
+			if route.description != "" && route.description != e.Description {
+				continue
+			}
+			if ok, err := route.handle(ctx, e, result); ok {
+				if err != nil {
+					return http.StatusInternalServerError, err
+				}
+				return http.StatusOK, nil
+			}
+		}
+	}
+
+	if m.fallback != nil {
+		if err := m.fallback(ctx, e); err != nil {
+			return http.StatusInternalServerError, err
+		}
+	}
+	return http.StatusOK, nil
+}
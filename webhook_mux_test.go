@@ -0,0 +1,143 @@
+// Copyright 2026 RetailNext, Inc.
+//
+// Licensed under the BSD 3-Clause License (the "License");
+// you may not use this file except in compliance with the License.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package easypost
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+func newTestEvent(t *testing.T, description, resultFile string) []byte {
+	t.Helper()
+	event := Event{Object: RecordTypeEvent, ID: "evt_1", Description: description}
+	if resultFile != "" {
+		result, err := os.ReadFile(resultFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+		event.Result = result
+	}
+	b, err := json.Marshal(event)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestWebHookMux(t *testing.T) {
+	var calls []string
+	failing := errors.New("failing")
+	m := NewWebHookMux(NewWebHookHandler("user", "secret"))
+	m.OnTrackerUpdated(func(ctx context.Context, e *Event, tracker *Tracker) error {
+		calls = append(calls, "tracker updated "+tracker.TrackingCode)
+		return nil
+	})
+	m.OnAddress(func(ctx context.Context, e *Event, a *Address) error {
+		calls = append(calls, "address "+a.ID)
+		return nil
+	})
+	m.OnShipment(func(ctx context.Context, e *Event, s *Shipment) error {
+		calls = append(calls, "shipment "+s.ID)
+		return failing
+	})
+	m.Fallback(func(ctx context.Context, e *Event) error {
+		calls = append(calls, "fallback "+e.Description)
+		return nil
+	})
+	var errs []error
+	m.OnError(func(ctx context.Context, e *Event, err error) {
+		errs = append(errs, err)
+	})
+
+	for _, test := range []struct {
+		name           string
+		body           []byte
+		noAuth         bool
+		expectedStatus int
+		expectedCall   string
+		expectedError  bool
+	}{
+		{
+			name:           "tracker updated",
+			body:           newTestEvent(t, EventTrackerUpdated, "test/trackers/EZ3000000003.json"),
+			expectedStatus: http.StatusOK,
+			expectedCall:   "tracker updated EZ1000000001",
+		},
+		{
+			name:           "tracker created falls back",
+			body:           newTestEvent(t, EventTrackerCreated, "test/trackers/EZ3000000003.json"),
+			expectedStatus: http.StatusOK,
+			expectedCall:   "fallback tracker.created",
+		},
+		{
+			name:           "address",
+			body:           newTestEvent(t, "address.verified", "test/addresses/valid_address.json"),
+			expectedStatus: http.StatusOK,
+			expectedCall:   "address adr_9fd4e689a7e5444a88c1bca671df552b",
+		},
+		{
+			name:           "failing handler",
+			body:           newTestEvent(t, "shipment.invoice.created", "test/shipments/shp_1.json"),
+			expectedStatus: http.StatusInternalServerError,
+			expectedCall:   "shipment shp_1",
+			expectedError:  true,
+		},
+		{
+			name:           "unsupported record",
+			body:           []byte(`{"object": "Event", "description": "batch.created", "result": {"object": "Batch"}}`),
+			expectedStatus: http.StatusOK,
+			expectedCall:   "fallback batch.created",
+		},
+		{
+			name:           "malformed result",
+			body:           []byte(`{"object": "Event", "description": "tracker.updated", "result": {"object": "Tracker", "weight": "heavy"}}`),
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  true,
+		},
+		{
+			name:           "malformed event",
+			body:           []byte(`{`),
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  true,
+		},
+		{
+			name:           "unauthorized",
+			body:           newTestEvent(t, EventTrackerUpdated, "test/trackers/EZ3000000003.json"),
+			noAuth:         true,
+			expectedStatus: http.StatusUnauthorized,
+			expectedError:  true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			calls, errs = nil, nil
+			r := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(test.body))
+			if !test.noAuth {
+				r.SetBasicAuth("user", "secret")
+			}
+			w := httptest.NewRecorder()
+			m.ServeHTTP(w, r)
+
+			if w.Code != test.expectedStatus {
+				t.Fatalf("unexpected status, expected: %d, got: %d", test.expectedStatus, w.Code)
+			}
+			if test.expectedCall == "" && len(calls) != 0 || test.expectedCall != "" && (len(calls) != 1 || calls[0] != test.expectedCall) {
+				t.Fatalf("unexpected calls, expected: %q, got: %q", test.expectedCall, calls)
+			}
+			if test.expectedError != (len(errs) == 1) {
+				t.Fatalf("unexpected errors: %v", errs)
+			}
+		})
+	}
+}
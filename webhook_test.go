@@ -10,10 +10,15 @@ package easypost
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"reflect"
 	"testing"
 )
@@ -116,3 +121,155 @@ func TestNewWebHookHandler(t *testing.T) {
 		t.Fatalf("trackers: \nexpected %+v\n     got %+v", expectedTracker, *tracker)
 	}
 }
+
+func TestEventGetResultShipment(t *testing.T) {
+	b, err := readTestShipmentFile("shp_1_bought")
+	if err != nil {
+		t.Fatalf("error reading file: %s", err)
+	}
+
+	result, err := Event{Object: RecordTypeEvent, Result: b}.GetResult()
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+	shipment, ok := result.(*Shipment)
+	if !ok {
+		t.Fatalf("unexpected record: %T", result)
+	}
+	if shipment.Tracker == nil || shipment.Tracker.TrackingCode != shipment.TrackingCode {
+		t.Fatalf("unexpected shipment tracker: %+v", shipment.Tracker)
+	}
+}
+
+func TestEventGetResultCustoms(t *testing.T) {
+	b, err := readTestCustomsFile("cstinfo_1")
+	if err != nil {
+		t.Fatalf("error reading file: %s", err)
+	}
+
+	result, err := Event{Object: RecordTypeEvent, Result: b}.GetResult()
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+	if _, ok := result.(*CustomsInfo); !ok {
+		t.Fatalf("unexpected record: %T", result)
+	}
+}
+
+func TestEventGetResultRefund(t *testing.T) {
+	b, err := os.ReadFile("./test/refunds/rfnd_1.json")
+	if err != nil {
+		t.Fatalf("error reading file: %s", err)
+	}
+
+	result, err := Event{Object: RecordTypeEvent, Description: "refund.successful", Result: b}.GetResult()
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+	refund, ok := result.(*Refund)
+	if !ok {
+		t.Fatalf("unexpected record: %T", result)
+	}
+	if refund.Status != RefundStatusRefunded || !refund.Status.Final() {
+		t.Fatalf("unexpected refund status: %s", refund.Status)
+	}
+}
+
+func signTestBody(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmacSignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestWebHookHandlerSignature(t *testing.T) {
+	body := []byte(`{"object": "Event", "id": "evt_1", "description": "tracker.updated"}`)
+	handler := NewWebHookHandler("", "", WithHMACSecrets("old", "new"))
+
+	for _, test := range []struct {
+		name      string
+		signature string
+		reason    string
+	}{
+		{name: "current secret", signature: signTestBody("new", body)},
+		{name: "previous secret", signature: signTestBody("old", body)},
+		{name: "missing", reason: "missing X-Hmac-Signature header"},
+		{name: "other scheme", signature: "sha1=abc", reason: "unsupported signature scheme"},
+		{name: "malformed", signature: hmacSignaturePrefix + "xyz", reason: "malformed signature"},
+		{name: "unknown secret", signature: signTestBody("other", body), reason: "signature mismatch"},
+		{name: "modified body", signature: signTestBody("new", append(body, ' ')), reason: "signature mismatch"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+			if test.signature != "" {
+				r.Header.Set(hmacSignatureHeader, test.signature)
+			}
+			event, err := handler(r)
+			if test.reason == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if event.ID != "evt_1" {
+					t.Fatalf("unexpected event: %+v", event)
+				}
+				return
+			}
+			var signatureError SignatureError
+			if !errors.As(err, &signatureError) || signatureError.Reason != test.reason {
+				t.Fatalf("signature error %q expected, got: %v", test.reason, err)
+			}
+		})
+	}
+}
+
+func TestWebHookHandlerSignatureAndBasicAuth(t *testing.T) {
+	body := []byte(`{"object": "Event", "id": "evt_1"}`)
+	handler := NewWebHookHandler("user", "secret", WithHMACSecrets("hmac"))
+
+	r := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+	r.Header.Set(hmacSignatureHeader, signTestBody("hmac", body))
+	if _, err := handler(r); err != unauthorizedError {
+		t.Fatalf("unauthorized error expected, got: %v", err)
+	}
+
+	r = httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+	r.SetBasicAuth("user", "secret")
+	r.Header.Set(hmacSignatureHeader, signTestBody("hmac", body))
+	if _, err := handler(r); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWebHookHandlerEmptySecret(t *testing.T) {
+	body := []byte(`{"object": "Event", "id": "evt_1"}`)
+	for _, secrets := range [][]string{{""}, {}} {
+		handler := NewWebHookHandler("", "", WithHMACSecrets(secrets...))
+		r := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+		r.Header.Set(hmacSignatureHeader, signTestBody("", body))
+		var signatureError SignatureError
+		if _, err := handler(r); !errors.As(err, &signatureError) {
+			t.Fatalf("%q: signature error expected, got: %v", secrets, err)
+		}
+	}
+
+	handler := NewWebHookHandler("", "", WithHMACSecrets("", "secret"))
+	r := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+	r.Header.Set(hmacSignatureHeader, signTestBody("", body))
+	if _, err := handler(r); err == nil {
+		t.Fatal("body signed with empty key accepted")
+	}
+}
+
+func TestWebHookHandlerMaxBodySize(t *testing.T) {
+	body := []byte(`{"object": "Event", "id": "evt_1", "description": "tracker.updated"}`)
+	handler := NewWebHookHandler("", "", WithMaxBodySize(int64(len(body)-1)))
+	_, err := handler(httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body)))
+	var maxBytesError *http.MaxBytesError
+	if !errors.As(err, &maxBytesError) {
+		t.Fatalf("body size error expected, got: %v", err)
+	}
+
+	handler = NewWebHookHandler("", "", WithMaxBodySize(int64(len(body))))
+	if _, err := handler(httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))); err != nil {
+		t.Fatal(err)
+	}
+}